@@ -8,38 +8,77 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"math/big"
 	"net/http"
+	"os"
 	"reflect"
-	"strings"
-	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-select/internal/codec"
+	httpclienttest "terraform-provider-select/internal/httpclient_test"
 )
 
+// init registers the one cross-cutting decode hook the provider's models
+// rely on: filter_expression_json is stored canonicalized (stable key
+// order) regardless of which resource or data source it appears on, so
+// Terraform doesn't see spurious diffs caused by the API reordering keys.
+func init() {
+	codec.RegisterGlobalDecodeHook("filter_expression_json", func(raw interface{}) (attr.Value, error) {
+		if raw == nil {
+			return types.StringNull(), nil
+		}
+		str, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", raw)
+		}
+		if normalized, err := normalizeJSON(str); err == nil {
+			return types.StringValue(normalized), nil
+		}
+		return types.StringValue(str), nil
+	})
+}
+
 type HTTPClient struct {
 	client         *http.Client
 	baseURL        string
 	apiKey         string
 	organizationId string
+	retryConfig    RetryConfig
 }
 
 func NewHTTPClient(apiKey, organizationId, baseURL string) *HTTPClient {
+	var transport http.RoundTripper = &http.Transport{
+		MaxConnsPerHost:     12,  // Allow 12 concurrent connections per host (slightly above Terraform's default parallelism of 10)
+		MaxIdleConns:        100, // Maximum idle connections across all hosts
+		MaxIdleConnsPerHost: 12,  // Maximum idle connections per host
+		IdleConnTimeout:     90 * time.Second,
+	}
+
+	// SELECT_VCR_CASSETTE lets acceptance tests record/replay requests
+	// through httpclient_test instead of hitting a live Select account; see
+	// that package for details. It's a no-op transport passthrough unless
+	// the env var is set. A stale or malformed cassette path falls back to
+	// the real transport rather than taking down the whole provider, since
+	// NewHTTPClient has no way to surface a diag.Diagnostics here.
+	wrapped, err := httpclienttest.WrapFromEnv(transport, organizationId)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "terraform-provider-select: ignoring SELECT_VCR_CASSETTE: %v\n", err)
+	} else {
+		transport = wrapped
+	}
+
 	return &HTTPClient{
 		client: &http.Client{
-			Transport: &http.Transport{
-				MaxConnsPerHost:     12,  // Allow 12 concurrent connections per host (slightly above Terraform's default parallelism of 10)
-				MaxIdleConns:        100, // Maximum idle connections across all hosts
-				MaxIdleConnsPerHost: 12,  // Maximum idle connections per host
-				IdleConnTimeout:     90 * time.Second,
-			},
-			Timeout: 90 * time.Second,
+			Transport: transport,
+			Timeout:   90 * time.Second,
 		},
 		baseURL:        baseURL,
 		apiKey:         apiKey,
 		organizationId: organizationId,
+		retryConfig:    DefaultRetryConfig(),
 	}
 }
 
@@ -47,7 +86,9 @@ func (c *HTTPClient) buildURL(endpoint string) string {
 	return fmt.Sprintf("%s%s", c.baseURL, endpoint)
 }
 
-func (c *HTTPClient) makeRequest(ctx context.Context, method, endpoint string, body io.Reader) (*http.Response, error) {
+// makeRequest issues a single HTTP request with no retry logic. Callers that
+// want transient failures retried should go through executeWithRetry.
+func (c *HTTPClient) makeRequest(ctx context.Context, method, endpoint string, body io.Reader, idempotencyKey, ifMatch string) (*http.Response, error) {
 	url := c.buildURL(endpoint)
 
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
@@ -59,6 +100,12 @@ func (c *HTTPClient) makeRequest(ctx context.Context, method, endpoint string, b
 		req.Header.Set("Content-Type", "application/json")
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -67,6 +114,70 @@ func (c *HTTPClient) makeRequest(ctx context.Context, method, endpoint string, b
 	return resp, nil
 }
 
+// executeWithRetry wraps makeRequest in a bounded retry loop with
+// exponential backoff and jitter. It retries 429/502/503/504 responses and
+// network-level errors, and honors ctx cancellation between attempts.
+// bodyBytes is re-sent verbatim on every attempt since the *http.Response
+// body of a prior attempt has already been drained by the caller.
+func (c *HTTPClient) executeWithRetry(ctx context.Context, method, endpoint string, bodyBytes []byte, idempotencyKey, ifMatch string) (*http.Response, string, []retryAttempt, error) {
+	cfg := c.retryConfig
+	if cfg.MaxAttempts < 1 {
+		cfg.MaxAttempts = 1
+	}
+
+	// POST is only retried when the caller supplied an idempotency key,
+	// since that's what makes retrying a create safe; cfg.RetryPost lets
+	// an operator disable that behavior entirely regardless.
+	retryableMethod := cfg.RetryPost && idempotencyKey != "" || method != http.MethodPost
+
+	deadline := time.Now().Add(cfg.MaxElapsedTime)
+	var attempts []retryAttempt
+
+	for attempt := 1; attempt <= cfg.MaxAttempts; attempt++ {
+		var body io.Reader
+		if bodyBytes != nil {
+			body = bytes.NewReader(bodyBytes)
+		}
+
+		resp, err := c.makeRequest(ctx, method, endpoint, body, idempotencyKey, ifMatch)
+		if err != nil {
+			attempts = append(attempts, retryAttempt{attempt: attempt, err: err})
+			if !retryableMethod || attempt == cfg.MaxAttempts || !isRetryableError(err) || time.Now().After(deadline) {
+				return nil, "", attempts, err
+			}
+
+			if sleepErr := sleepCtx(ctx, backoffDelay(cfg, attempt)); sleepErr != nil {
+				return nil, "", attempts, sleepErr
+			}
+			continue
+		}
+
+		bodyStr, readErr := readResponseBody(resp)
+		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+		resp.Body.Close()
+		if readErr != nil {
+			attempts = append(attempts, retryAttempt{attempt: attempt, err: readErr})
+			return nil, "", attempts, readErr
+		}
+
+		attempts = append(attempts, retryAttempt{attempt: attempt, statusCode: resp.StatusCode})
+		if !retryableMethod || !isRetryableStatus(resp.StatusCode) || attempt == cfg.MaxAttempts || time.Now().After(deadline) {
+			return resp, bodyStr, attempts, nil
+		}
+
+		delay := backoffDelay(cfg, attempt)
+		if retryAfter > 0 {
+			delay = retryAfter
+		}
+		if sleepErr := sleepCtx(ctx, delay); sleepErr != nil {
+			return nil, "", attempts, sleepErr
+		}
+	}
+
+	// Unreachable: the loop always returns on its final attempt.
+	return nil, "", attempts, fmt.Errorf("retry loop exhausted without a terminal result")
+}
+
 func readResponseBody(resp *http.Response) (string, error) {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -93,107 +204,43 @@ func handleJSONError(operation string, err error) diag.Diagnostics {
 	}
 }
 
-// convertTerraformToAPI converts Terraform framework types to simple Go types for JSON marshaling
-// This handles types.String -> string, types.Int64 -> int64, etc.
+// isNotFoundDiagnostic reports whether diags is the "Resource Not Found"
+// warning handleJSONResponse returns for a 404. That's the right severity
+// for a resource's Read/refresh (drop silently from state), but a data
+// source looking an id up directly needs to tell it apart from a warning
+// worth merely surfacing, since a 404 there means the id doesn't exist at
+// all.
+func isNotFoundDiagnostic(diags diag.Diagnostics) bool {
+	for _, d := range diags {
+		if d.Severity() == diag.SeverityWarning && d.Summary() == "Resource Not Found" {
+			return true
+		}
+	}
+	return false
+}
+
+// convertTerraformToAPI converts a tfsdk struct (or pointer to one) into a
+// plain map ready for json.Marshal, via a Codec compiled once per struct
+// type and cached by codec.For. See the codec package for the encoding
+// rules (framework type handling, nested structs/slices, custom hooks).
 func convertTerraformToAPI(src interface{}) interface{} {
 	if src == nil {
 		return nil
 	}
 
 	srcValue := reflect.ValueOf(src)
-	if srcValue.Kind() == reflect.Ptr {
+	for srcValue.Kind() == reflect.Ptr {
 		if srcValue.IsNil() {
 			return nil
 		}
 		srcValue = srcValue.Elem()
 	}
 
-	switch srcValue.Type() {
-	// Handle Terraform framework types
-	case reflect.TypeOf(types.String{}):
-		tfString := srcValue.Interface().(types.String)
-		if tfString.IsNull() || tfString.IsUnknown() {
-			return nil
-		}
-		return tfString.ValueString()
-
-	case reflect.TypeOf(types.Int64{}):
-		tfInt64 := srcValue.Interface().(types.Int64)
-		if tfInt64.IsNull() || tfInt64.IsUnknown() {
-			return nil
-		}
-		return tfInt64.ValueInt64()
-
-	case reflect.TypeOf(types.Bool{}):
-		tfBool := srcValue.Interface().(types.Bool)
-		if tfBool.IsNull() || tfBool.IsUnknown() {
-			return nil
-		}
-		return tfBool.ValueBool()
-
-	case reflect.TypeOf(types.Float64{}):
-		tfFloat64 := srcValue.Interface().(types.Float64)
-		if tfFloat64.IsNull() || tfFloat64.IsUnknown() {
-			return nil
-		}
-		return tfFloat64.ValueFloat64()
-
-	case reflect.TypeOf(types.Number{}):
-		tfNumber := srcValue.Interface().(types.Number)
-		if tfNumber.IsNull() || tfNumber.IsUnknown() {
-			return nil
-		}
-		// Convert types.Number to float64 for JSON serialization
-		bigFloat := tfNumber.ValueBigFloat()
-		if bigFloat == nil {
-			return nil
-		}
-		float64Value, _ := bigFloat.Float64()
-		return float64Value
+	if srcValue.Kind() != reflect.Struct {
+		return srcValue.Interface()
 	}
 
-	// Handle structs by recursively converting fields
-	if srcValue.Kind() == reflect.Struct {
-		result := make(map[string]interface{})
-		srcType := srcValue.Type()
-
-		for i := 0; i < srcValue.NumField(); i++ {
-			field := srcType.Field(i)
-			fieldValue := srcValue.Field(i)
-
-			if !fieldValue.CanInterface() {
-				continue
-			}
-
-			jsonTag := field.Tag.Get("json")
-			if jsonTag == "" || jsonTag == "-" {
-				jsonTag = field.Tag.Get("tfsdk")
-			}
-			if jsonTag == "" {
-				jsonTag = field.Name
-			}
-
-			if commaIdx := len(jsonTag); commaIdx > 0 {
-				for j, char := range jsonTag {
-					if char == ',' {
-						commaIdx = j
-						break
-					}
-				}
-				jsonTag = jsonTag[:commaIdx]
-			}
-
-			convertedValue := convertTerraformToAPI(fieldValue.Interface())
-
-			if convertedValue != nil {
-				result[jsonTag] = convertedValue
-			}
-		}
-
-		return result
-	}
-
-	return srcValue.Interface()
+	return codec.For(srcValue.Type()).Encode(src)
 }
 
 // normalizeJSON normalizes a JSON string to ensure consistent key ordering
@@ -211,135 +258,95 @@ func normalizeJSON(jsonStr string) (string, error) {
 	return string(normalized), nil
 }
 
-// updateTerraformFromAPI updates Terraform framework types from simple Go types (JSON response)
-func updateTerraformFromAPI(dst interface{}, src map[string]interface{}) {
+// updateTerraformFromAPI populates dst (a pointer to a tfsdk struct) from
+// src, the decoded JSON response body, via the same compiled Codec used by
+// convertTerraformToAPI. It returns the Codec's decode error, if any,
+// instead of swallowing it, so a field type the codec can't handle fails
+// the request rather than silently coming back null.
+func updateTerraformFromAPI(dst interface{}, src map[string]interface{}) error {
 	dstValue := reflect.ValueOf(dst)
 	if dstValue.Kind() != reflect.Ptr || dstValue.IsNil() {
-		return
+		return fmt.Errorf("updateTerraformFromAPI: dst must be a non-nil pointer, got %T", dst)
 	}
-
-	dstValue = dstValue.Elem()
-	if dstValue.Kind() != reflect.Struct {
-		return
+	if dstValue.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("updateTerraformFromAPI: dst must point to a struct, got %s", dstValue.Elem().Kind())
 	}
 
-	dstType := dstValue.Type()
-
-	for i := 0; i < dstValue.NumField(); i++ {
-		field := dstType.Field(i)
-		fieldValue := dstValue.Field(i)
-
-		if !fieldValue.CanSet() {
-			continue
-		}
-
-		jsonTag := field.Tag.Get("json")
-		if jsonTag == "" || jsonTag == "-" {
-			jsonTag = field.Tag.Get("tfsdk")
-		}
-		if jsonTag == "" {
-			jsonTag = field.Name
-		}
-
-		if commaIdx := len(jsonTag); commaIdx > 0 {
-			for j, char := range jsonTag {
-				if char == ',' {
-					commaIdx = j
-					break
-				}
-			}
-			jsonTag = jsonTag[:commaIdx]
-		}
-
-		apiValue, exists := src[jsonTag]
-		if !exists {
-			continue
-		}
-
-		switch fieldValue.Type() {
-		case reflect.TypeOf(types.String{}):
-			if apiValue == nil {
-				fieldValue.Set(reflect.ValueOf(types.StringNull()))
-			} else if str, ok := apiValue.(string); ok {
-				// Normalize JSON for filter_expression_json field
-				if jsonTag == "filter_expression_json" {
-					if normalizedStr, err := normalizeJSON(str); err == nil {
-						fieldValue.Set(reflect.ValueOf(types.StringValue(normalizedStr)))
-					} else {
-						fieldValue.Set(reflect.ValueOf(types.StringValue(str)))
-					}
-				} else {
-					fieldValue.Set(reflect.ValueOf(types.StringValue(str)))
-				}
-			}
+	return codec.For(dstValue.Elem().Type()).Decode(dst, src)
+}
 
-		case reflect.TypeOf(types.Int64{}):
-			if apiValue == nil {
-				fieldValue.Set(reflect.ValueOf(types.Int64Null()))
-			} else {
-				switch v := apiValue.(type) {
-				case int64:
-					fieldValue.Set(reflect.ValueOf(types.Int64Value(v)))
-				case float64:
-					fieldValue.Set(reflect.ValueOf(types.Int64Value(int64(v))))
-				}
-			}
+// unmarshalJSONSlice decodes a JSON array response into sliceValue, which
+// must be an addressable reflect.Value of slice kind. Each element is
+// decoded the same way a single-object response would be: plain Go structs
+// are unmarshaled directly, while tfsdk structs built from framework types
+// go through updateTerraformFromAPI.
+func unmarshalJSONSlice(bodyStr string, sliceValue reflect.Value) error {
+	elemType := sliceValue.Type().Elem()
 
-		case reflect.TypeOf(types.Bool{}):
-			if apiValue == nil {
-				fieldValue.Set(reflect.ValueOf(types.BoolNull()))
-			} else if b, ok := apiValue.(bool); ok {
-				fieldValue.Set(reflect.ValueOf(types.BoolValue(b)))
-			}
+	if elemType.Kind() != reflect.Struct || !codec.IsModel(elemType) {
+		return json.Unmarshal([]byte(bodyStr), sliceValue.Addr().Interface())
+	}
 
-		case reflect.TypeOf(types.Float64{}):
-			if apiValue == nil {
-				fieldValue.Set(reflect.ValueOf(types.Float64Null()))
-			} else if f, ok := apiValue.(float64); ok {
-				fieldValue.Set(reflect.ValueOf(types.Float64Value(f)))
-			}
+	var rawItems []map[string]interface{}
+	if err := json.Unmarshal([]byte(bodyStr), &rawItems); err != nil {
+		return err
+	}
 
-		case reflect.TypeOf(types.Number{}):
-			if apiValue == nil {
-				fieldValue.Set(reflect.ValueOf(types.NumberNull()))
-			} else {
-				switch v := apiValue.(type) {
-				case int64:
-					fieldValue.Set(reflect.ValueOf(types.NumberValue(big.NewFloat(float64(v)))))
-				case float64:
-					fieldValue.Set(reflect.ValueOf(types.NumberValue(big.NewFloat(v))))
-				case int:
-					fieldValue.Set(reflect.ValueOf(types.NumberValue(big.NewFloat(float64(v)))))
-				}
-			}
+	result := reflect.MakeSlice(sliceValue.Type(), 0, len(rawItems))
+	for _, raw := range rawItems {
+		item := reflect.New(elemType)
+		if err := updateTerraformFromAPI(item.Interface(), raw); err != nil {
+			return err
 		}
+		result = reflect.Append(result, item.Elem())
 	}
+	sliceValue.Set(result)
+	return nil
 }
 
 type VersionResponse struct {
-	Id               string `json:"id"`
-	CreatedAt        string `json:"created_at"`
-	CreatedBy        string `json:"created_by"`
-	UsageGroupSetId  string `json:"usage_group_set_id"`
+	Id              string `json:"id"`
+	CreatedAt       string `json:"created_at"`
+	CreatedBy       string `json:"created_by"`
+	UsageGroupSetId string `json:"usage_group_set_id"`
 }
 
 type APIClient struct {
 	httpClient *HTTPClient
-	// Ensures all resources in the same apply use the same version
-	versionID string
-	versionOnce sync.Once
-	versionError error
+	// versions hands out one shared draft version per usage_group_set for
+	// the lifetime of this client, so every resource mutating that set
+	// during the same apply operates on the same draft.
+	versions *VersionManager
+	// etags caches the last-seen ETag per endpoint so mutating requests
+	// can send it back as If-Match for optimistic concurrency control.
+	etags *ETagCache
 }
 
 func NewAPIClient(apiKey, organizationId, baseURL string) *APIClient {
-	return &APIClient{
+	client := &APIClient{
 		httpClient: NewHTTPClient(apiKey, organizationId, baseURL),
+		etags:      NewETagCache(),
 	}
+	client.versions = NewVersionManager(client)
+	return client
+}
+
+// NewAPIClientWithRetryConfig is like NewAPIClient but allows the caller
+// (the provider's Configure step) to override the default retry behavior
+// from the retry_max_attempts/retry_max_elapsed/retry_initial_interval
+// provider schema attributes.
+func NewAPIClientWithRetryConfig(apiKey, organizationId, baseURL string, retryConfig RetryConfig) *APIClient {
+	client := NewAPIClient(apiKey, organizationId, baseURL)
+	client.httpClient.retryConfig = retryConfig
+	return client
 }
 
-// doJSONRequest handles JSON requests and responses
+// doJSONRequest handles JSON requests and responses, transparently retrying
+// transient failures via executeWithRetry. POST requests are assigned a
+// fresh idempotency key so a retried create cannot produce a duplicate
+// usage group server-side.
 func (c *APIClient) doJSONRequest(ctx context.Context, method, endpoint string, requestBody interface{}, responseBody interface{}) diag.Diagnostics {
-	var body io.Reader
+	var bodyBytes []byte
 
 	if requestBody != nil {
 		convertedRequest := convertTerraformToAPI(requestBody)
@@ -348,40 +355,75 @@ func (c *APIClient) doJSONRequest(ctx context.Context, method, endpoint string,
 		if err != nil {
 			return handleJSONError("marshal request", err)
 		}
-		body = bytes.NewBuffer(jsonData)
+		bodyBytes = jsonData
 	}
 
-	resp, err := c.httpClient.makeRequest(ctx, method, endpoint, body)
-	if err != nil {
-		return handleHTTPError(fmt.Sprintf("%s %s", method, endpoint), err)
+	var idempotencyKey string
+	if method == http.MethodPost {
+		idempotencyKey = newIdempotencyKey()
+	}
+
+	var ifMatch string
+	if method == http.MethodPut || method == http.MethodPatch {
+		ifMatch = c.etags.Get(endpoint)
 	}
-	defer resp.Body.Close()
 
-	bodyStr, err := readResponseBody(resp)
+	resp, bodyStr, attempts, err := c.httpClient.executeWithRetry(ctx, method, endpoint, bodyBytes, idempotencyKey, ifMatch)
 	if err != nil {
 		return diag.Diagnostics{
-			diag.NewErrorDiagnostic("Response Read Error", fmt.Sprintf("Failed to read response body: %v", err)),
+			diag.NewErrorDiagnostic(
+				"HTTP Request Error",
+				fmt.Sprintf("Failed to %s %s after %s: %v", method, endpoint, attemptSummary(attempts), err),
+			),
+		}
+	}
+
+	if len(attempts) > 1 {
+		diags := diag.Diagnostics{
+			diag.NewWarningDiagnostic(
+				"Request Retried",
+				fmt.Sprintf("%s %s succeeded after %s", method, endpoint, attemptSummary(attempts)),
+			),
+		}
+		return append(diags, c.handleJSONResponse(method, endpoint, resp, bodyStr, responseBody)...)
+	}
+
+	return c.handleJSONResponse(method, endpoint, resp, bodyStr, responseBody)
+}
+
+func (c *APIClient) handleJSONResponse(method, endpoint string, resp *http.Response, bodyStr string, responseBody interface{}) diag.Diagnostics {
+	if method == http.MethodGet {
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			c.etags.Set(endpoint, etag)
 		}
 	}
 
 	switch resp.StatusCode {
+	case http.StatusPreconditionFailed:
+		c.etags.Invalidate(endpoint)
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Concurrent Modification Detected",
+				fmt.Sprintf(
+					"%s %s was rejected because the resource changed since it was last read (If-Match precondition failed). Refresh state (terraform apply -refresh-only or terraform refresh) and retry.",
+					method, endpoint,
+				),
+			),
+		}
+
 	case http.StatusOK, http.StatusCreated:
 		if responseBody != nil && len(bodyStr) > 0 {
 			responseValue := reflect.ValueOf(responseBody)
+			if responseValue.Kind() == reflect.Ptr && responseValue.Elem().Kind() == reflect.Slice {
+				if err := unmarshalJSONSlice(bodyStr, responseValue.Elem()); err != nil {
+					return handleJSONError("unmarshal response", err)
+				}
+				return nil
+			}
 			if responseValue.Kind() == reflect.Ptr && responseValue.Elem().Kind() == reflect.Struct {
 				responseType := responseValue.Elem().Type()
-				isRegularStruct := false
-				for i := 0; i < responseType.NumField(); i++ {
-					field := responseType.Field(i)
-					if _, hasJSON := field.Tag.Lookup("json"); hasJSON {
-						if field.Type.PkgPath() == "" || !strings.Contains(field.Type.String(), "types.") {
-							isRegularStruct = true
-							break
-						}
-					}
-				}
-				
-				if isRegularStruct {
+
+				if !codec.IsModel(responseType) {
 					if err := json.Unmarshal([]byte(bodyStr), responseBody); err != nil {
 						return handleJSONError("unmarshal response", err)
 					}
@@ -390,7 +432,9 @@ func (c *APIClient) doJSONRequest(ctx context.Context, method, endpoint string,
 					if err := json.Unmarshal([]byte(bodyStr), &apiResponse); err != nil {
 						return handleJSONError("unmarshal response", err)
 					}
-					updateTerraformFromAPI(responseBody, apiResponse)
+					if err := updateTerraformFromAPI(responseBody, apiResponse); err != nil {
+						return handleJSONError("decode response", err)
+					}
 				}
 			}
 		}
@@ -421,6 +465,12 @@ func (c *APIClient) Put(ctx context.Context, endpoint string, requestBody interf
 	return c.doJSONRequest(ctx, "PUT", endpoint, requestBody, responseBody)
 }
 
+// Patch applies a partial update. Like Put, it sends If-Match using the
+// ETag captured from the most recent Get of endpoint, if any.
+func (c *APIClient) Patch(ctx context.Context, endpoint string, requestBody interface{}, responseBody interface{}) diag.Diagnostics {
+	return c.doJSONRequest(ctx, http.MethodPatch, endpoint, requestBody, responseBody)
+}
+
 func (c *APIClient) Delete(ctx context.Context, endpoint string) diag.Diagnostics {
 	return c.doJSONRequest(ctx, "DELETE", endpoint, nil, nil)
 }
@@ -429,39 +479,20 @@ func (c *APIClient) GetOrganizationId() string {
 	return c.httpClient.organizationId
 }
 
-// GetOrCreateVersion creates a new version for the usage group set if one hasn't been created yet
-// for the current apply operation. Returns the version ID.
+// GetOrCreateVersion creates a new version for the usage group set if one
+// hasn't been created yet for the current apply operation, and returns the
+// same version ID on every subsequent call for that set. It delegates to
+// the client's VersionManager, which keys the shared draft by
+// usageGroupSetId so concurrent operations against different sets in the
+// same apply don't collide.
 func (c *APIClient) GetOrCreateVersion(ctx context.Context, usageGroupSetId string) (string, diag.Diagnostics) {
-	c.versionOnce.Do(func() {
-		orgId := c.GetOrganizationId()
-		endpoint := fmt.Sprintf("/api/%s/usage-group-sets/%s/versions", orgId, usageGroupSetId)
-
-		versionRequest := map[string]interface{}{}
-
-		var versionResponse VersionResponse
-		creationDiags := c.Post(ctx, endpoint, versionRequest, &versionResponse)
-
-		if creationDiags.HasError() {
-			c.versionError = fmt.Errorf("failed to create version: %v", creationDiags)
-			return
-		}
-		
-		if versionResponse.Id == "" {
-			c.versionError = fmt.Errorf("API returned empty version ID")
-			return
-		}
-
-		c.versionID = versionResponse.Id
-	})
-
-	if c.versionError != nil {
-		return "", diag.Diagnostics{
-			diag.NewErrorDiagnostic(
-				"Version Creation Error",
-				c.versionError.Error(),
-			),
-		}
-	}
+	return c.versions.GetOrCreate(ctx, usageGroupSetId)
+}
 
-	return c.versionID, diag.Diagnostics{}
+// VersionManager returns the client's shared VersionManager, so resources
+// that need to publish or roll back a draft (select_usage_group_set_version)
+// can act on the same draft that select_usage_group resources already
+// opened during this apply.
+func (c *APIClient) VersionManager() *VersionManager {
+	return c.versions
 }
@@ -0,0 +1,498 @@
+// SPDX-License-Identifier: MPL-2.0
+
+// Package codec replaces the provider's original per-call reflection walk
+// (convertTerraformToAPI / updateTerraformFromAPI) with a plan that is
+// compiled once per tfsdk struct type and cached, so repeated requests
+// against the same resource model don't re-discover its shape from
+// scratch, and so schema drift (a json/tfsdk tag naming two different
+// things) is caught at compile time instead of silently dropping data at
+// apply time.
+package codec
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EncodeHook customizes how a single field is converted from its Go/tfsdk
+// value to the JSON-ready value sent to the API. It receives the field's
+// already-converted value and returns the value to actually send.
+type EncodeHook func(value interface{}) interface{}
+
+// DecodeHook customizes how a single field's raw API value is converted
+// back into the tfsdk value stored on the model. It receives the raw JSON
+// value for the field and returns the framework attr.Value to set.
+type DecodeHook func(raw interface{}) (attr.Value, error)
+
+// attrValueType is the attr.Value interface type, used to detect whether a
+// struct field is a framework type (types.String and friends all implement
+// it) without hardcoding the list of framework types twice.
+var attrValueType = reflect.TypeOf((*attr.Value)(nil)).Elem()
+
+type fieldPlan struct {
+	index      int
+	name       string // the wire (json) name
+	encodeHook EncodeHook
+	decodeHook DecodeHook
+}
+
+// Codec is a compiled encode/decode plan for a single tfsdk struct type.
+type Codec struct {
+	fields  []fieldPlan
+	isModel bool
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = map[reflect.Type]*Codec{}
+
+	hooksMu sync.Mutex
+	// hooks are registered per (struct type, field name) and consulted at
+	// compile time, so RegisterHook must be called before the first
+	// Encode/Decode of that type.
+	encodeHooks = map[reflect.Type]map[string]EncodeHook{}
+	decodeHooks = map[reflect.Type]map[string]DecodeHook{}
+
+	globalHooksMu     sync.Mutex
+	globalDecodeHooks = map[string]DecodeHook{}
+)
+
+// RegisterGlobalDecodeHook attaches a decode hook to every field whose wire
+// (json/tfsdk) name matches wireName, across all struct types. This mirrors
+// the provider's original behavior of normalizing any field named
+// "filter_expression_json" regardless of which model it appeared on.
+func RegisterGlobalDecodeHook(wireName string, hook DecodeHook) {
+	globalHooksMu.Lock()
+	defer globalHooksMu.Unlock()
+	globalDecodeHooks[wireName] = hook
+}
+
+// RegisterHook attaches a custom encode and/or decode hook to fieldName of
+// structType (e.g. the filter_expression_json normalization on
+// UsageGroupModel). Either hook may be nil. It must be called before the
+// type is first compiled; registering after the fact has no effect on an
+// already-cached Codec.
+func RegisterHook(structType reflect.Type, fieldName string, encode EncodeHook, decode DecodeHook) {
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+
+	if encode != nil {
+		if encodeHooks[structType] == nil {
+			encodeHooks[structType] = map[string]EncodeHook{}
+		}
+		encodeHooks[structType][fieldName] = encode
+	}
+	if decode != nil {
+		if decodeHooks[structType] == nil {
+			decodeHooks[structType] = map[string]DecodeHook{}
+		}
+		decodeHooks[structType][fieldName] = decode
+	}
+}
+
+// For returns the compiled Codec for t (a struct type), compiling and
+// caching it on first use. It panics if t's tfsdk/json tags disagree,
+// since that represents schema drift that should fail a test immediately
+// rather than silently drop data in production.
+func For(t reflect.Type) *Codec {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	registryMu.Lock()
+	if c, ok := registry[t]; ok {
+		registryMu.Unlock()
+		return c
+	}
+	registryMu.Unlock()
+
+	c, err := compile(t)
+	if err != nil {
+		panic(fmt.Sprintf("codec: %s: %v", t, err))
+	}
+
+	registryMu.Lock()
+	registry[t] = c
+	registryMu.Unlock()
+
+	return c
+}
+
+func compile(t reflect.Type) (*Codec, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct type, got %s", t.Kind())
+	}
+
+	hooksMu.Lock()
+	structEncodeHooks := encodeHooks[t]
+	structDecodeHooks := decodeHooks[t]
+	hooksMu.Unlock()
+
+	var fields []fieldPlan
+	isModel := false
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+
+		jsonTag, hasJSON := lookupTagName(field.Tag.Get("json"))
+		tfsdkTag, hasTfsdk := lookupTagName(field.Tag.Get("tfsdk"))
+
+		if hasJSON && hasTfsdk && jsonTag != tfsdkTag {
+			return nil, fmt.Errorf(
+				"field %s: json tag %q and tfsdk tag %q disagree; a struct used as both a JSON wire model and a tfsdk model must name each field the same way in both tags",
+				field.Name, jsonTag, tfsdkTag,
+			)
+		}
+
+		name := jsonTag
+		if name == "" {
+			name = tfsdkTag
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		if field.Type.Implements(attrValueType) {
+			isModel = true
+		}
+
+		fields = append(fields, fieldPlan{
+			index:      i,
+			name:       name,
+			encodeHook: structEncodeHooks[field.Name],
+			decodeHook: structDecodeHooks[field.Name],
+		})
+	}
+
+	return &Codec{fields: fields, isModel: isModel}, nil
+}
+
+// IsModel reports whether t is a tfsdk model struct (has at least one field
+// whose type implements attr.Value) as opposed to a plain JSON wire struct
+// like VersionResponse. Callers that need to pick between json.Unmarshal and
+// Decode use this instead of re-deriving the answer per call by sniffing
+// struct tags themselves.
+func IsModel(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return For(t).isModel
+}
+
+func lookupTagName(tag string) (string, bool) {
+	if tag == "" || tag == "-" {
+		return "", false
+	}
+	if idx := strings.IndexByte(tag, ','); idx >= 0 {
+		tag = tag[:idx]
+	}
+	return tag, tag != ""
+}
+
+// Encode converts src (a pointer to, or value of, a tfsdk struct) into a
+// map ready for json.Marshal, applying any registered encode hooks.
+func (c *Codec) Encode(src interface{}) map[string]interface{} {
+	v := reflect.ValueOf(src)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	result := make(map[string]interface{}, len(c.fields))
+	for _, f := range c.fields {
+		fieldValue := v.Field(f.index)
+		if !fieldValue.CanInterface() {
+			continue
+		}
+
+		encoded := encodeValue(fieldValue.Interface())
+		if f.encodeHook != nil {
+			encoded = f.encodeHook(encoded)
+		}
+		if encoded != nil {
+			result[f.name] = encoded
+		}
+	}
+	return result
+}
+
+// Decode populates dst (a pointer to a tfsdk struct) from src, the decoded
+// JSON object returned by the API, applying any registered decode hooks.
+func (c *Codec) Decode(dst interface{}, src map[string]interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("dst must be a non-nil pointer, got %T", dst)
+	}
+	v = v.Elem()
+
+	for _, f := range c.fields {
+		raw, exists := src[f.name]
+		if !exists {
+			continue
+		}
+
+		fieldValue := v.Field(f.index)
+		if !fieldValue.CanSet() {
+			continue
+		}
+
+		decodeHook := f.decodeHook
+		if decodeHook == nil {
+			globalHooksMu.Lock()
+			decodeHook = globalDecodeHooks[f.name]
+			globalHooksMu.Unlock()
+		}
+
+		if decodeHook != nil {
+			value, err := decodeHook(raw)
+			if err != nil {
+				return fmt.Errorf("field %s: %w", f.name, err)
+			}
+			fieldValue.Set(reflect.ValueOf(value))
+			continue
+		}
+
+		decoded, err := decodeValue(fieldValue.Type(), raw)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.name, err)
+		}
+		if decoded.IsValid() {
+			fieldValue.Set(decoded)
+		}
+	}
+	return nil
+}
+
+// encodeValue converts a single Go/framework value to its JSON-ready form,
+// recursing into nested structs, pointers, and slices.
+func encodeValue(src interface{}) interface{} {
+	if src == nil {
+		return nil
+	}
+
+	if v, ok := src.(attr.Value); ok {
+		return encodeAttrValue(v)
+	}
+
+	v := reflect.ValueOf(src)
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return encodeValue(v.Elem().Interface())
+
+	case reflect.Struct:
+		return For(v.Type()).Encode(src)
+
+	case reflect.Slice, reflect.Array:
+		items := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			items = append(items, encodeValue(v.Index(i).Interface()))
+		}
+		return items
+
+	default:
+		return src
+	}
+}
+
+// encodeAttrValue converts a terraform-plugin-framework attr.Value to a
+// plain Go value suitable for json.Marshal.
+func encodeAttrValue(v attr.Value) interface{} {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	switch val := v.(type) {
+	case types.String:
+		return val.ValueString()
+	case types.Int64:
+		return val.ValueInt64()
+	case types.Bool:
+		return val.ValueBool()
+	case types.Float64:
+		return val.ValueFloat64()
+	case types.Number:
+		f := val.ValueBigFloat()
+		if f == nil {
+			return nil
+		}
+		result, _ := f.Float64()
+		return result
+	case types.Dynamic:
+		return encodeAttrValue(val.UnderlyingValue())
+	case types.List:
+		return encodeElements(val.Elements())
+	case types.Set:
+		return encodeElements(val.Elements())
+	case types.Map:
+		out := make(map[string]interface{}, len(val.Elements()))
+		for k, elem := range val.Elements() {
+			out[k] = encodeAttrValue(elem)
+		}
+		return out
+	case types.Object:
+		out := make(map[string]interface{}, len(val.Attributes()))
+		for k, elem := range val.Attributes() {
+			out[k] = encodeAttrValue(elem)
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+func encodeElements(elements []attr.Value) []interface{} {
+	out := make([]interface{}, 0, len(elements))
+	for _, elem := range elements {
+		out = append(out, encodeAttrValue(elem))
+	}
+	return out
+}
+
+// composite framework types can't be decoded by reflection alone: a
+// types.List field, say, carries no information about its ElementType once
+// erased to reflect.Type, so there's no way to construct a correct zero
+// value. Fields of these types need a DecodeHook registered via
+// RegisterHook or RegisterGlobalDecodeHook.
+var compositeFrameworkTypes = map[reflect.Type]bool{
+	reflect.TypeOf(types.List{}):    true,
+	reflect.TypeOf(types.Set{}):     true,
+	reflect.TypeOf(types.Map{}):     true,
+	reflect.TypeOf(types.Object{}):  true,
+	reflect.TypeOf(types.Dynamic{}): true,
+}
+
+// decodeValue converts a raw JSON value into a reflect.Value assignable to
+// fieldType: the framework's primitive types directly, and pointers,
+// slices/arrays, and nested structs by recursing. Composite framework types
+// (List/Set/Map/Object/Dynamic) have no hook registered return an error
+// rather than silently leaving the field unset, since unlike the other
+// unsupported cases those can never be satisfied by reflection alone.
+func decodeValue(fieldType reflect.Type, raw interface{}) (reflect.Value, error) {
+	if compositeFrameworkTypes[fieldType] {
+		return reflect.Value{}, fmt.Errorf(
+			"%s has no registered DecodeHook; composite framework types can't be decoded by reflection alone (register one via RegisterHook or RegisterGlobalDecodeHook)",
+			fieldType,
+		)
+	}
+
+	switch fieldType {
+	case reflect.TypeOf(types.String{}):
+		if raw == nil {
+			return reflect.ValueOf(types.StringNull()), nil
+		}
+		s, ok := raw.(string)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected string, got %T", raw)
+		}
+		return reflect.ValueOf(types.StringValue(s)), nil
+
+	case reflect.TypeOf(types.Int64{}):
+		if raw == nil {
+			return reflect.ValueOf(types.Int64Null()), nil
+		}
+		switch n := raw.(type) {
+		case float64:
+			return reflect.ValueOf(types.Int64Value(int64(n))), nil
+		case int64:
+			return reflect.ValueOf(types.Int64Value(n)), nil
+		default:
+			return reflect.Value{}, fmt.Errorf("expected number, got %T", raw)
+		}
+
+	case reflect.TypeOf(types.Bool{}):
+		if raw == nil {
+			return reflect.ValueOf(types.BoolNull()), nil
+		}
+		b, ok := raw.(bool)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected bool, got %T", raw)
+		}
+		return reflect.ValueOf(types.BoolValue(b)), nil
+
+	case reflect.TypeOf(types.Float64{}):
+		if raw == nil {
+			return reflect.ValueOf(types.Float64Null()), nil
+		}
+		f, ok := raw.(float64)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected number, got %T", raw)
+		}
+		return reflect.ValueOf(types.Float64Value(f)), nil
+
+	case reflect.TypeOf(types.Number{}):
+		if raw == nil {
+			return reflect.ValueOf(types.NumberNull()), nil
+		}
+		switch n := raw.(type) {
+		case float64:
+			return reflect.ValueOf(types.NumberValue(big.NewFloat(n))), nil
+		case int64:
+			return reflect.ValueOf(types.NumberValue(big.NewFloat(float64(n)))), nil
+		case int:
+			return reflect.ValueOf(types.NumberValue(big.NewFloat(float64(n)))), nil
+		default:
+			return reflect.Value{}, fmt.Errorf("expected number, got %T", raw)
+		}
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Ptr:
+		if raw == nil {
+			return reflect.Zero(fieldType), nil
+		}
+		elem, err := decodeValue(fieldType.Elem(), raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		ptr := reflect.New(fieldType.Elem())
+		ptr.Elem().Set(elem)
+		return ptr, nil
+
+	case reflect.Struct:
+		if raw == nil {
+			return reflect.Zero(fieldType), nil
+		}
+		rawMap, ok := raw.(map[string]interface{})
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected object for nested struct %s, got %T", fieldType, raw)
+		}
+		nested := reflect.New(fieldType)
+		if err := For(fieldType).Decode(nested.Interface(), rawMap); err != nil {
+			return reflect.Value{}, err
+		}
+		return nested.Elem(), nil
+
+	case reflect.Slice:
+		if raw == nil {
+			return reflect.Zero(fieldType), nil
+		}
+		rawSlice, ok := raw.([]interface{})
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected array for %s, got %T", fieldType, raw)
+		}
+		result := reflect.MakeSlice(fieldType, 0, len(rawSlice))
+		for _, item := range rawSlice {
+			decoded, err := decodeValue(fieldType.Elem(), item)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			result = reflect.Append(result, decoded)
+		}
+		return result, nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("%s: no decode rule for this field kind; register a DecodeHook via RegisterHook", fieldType)
+}
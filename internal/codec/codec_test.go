@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package codec
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type decodeChildModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type decodeTestModel struct {
+	Id       types.String       `tfsdk:"id"`
+	Name     types.String       `tfsdk:"name"`
+	Tags     types.List         `json:"tags"`
+	Child    *decodeChildModel  `tfsdk:"child"`
+	Children []decodeChildModel `tfsdk:"children"`
+}
+
+func TestIsModel(t *testing.T) {
+	if !IsModel(reflect.TypeOf(decodeTestModel{})) {
+		t.Error("IsModel(decodeTestModel) = false, want true")
+	}
+
+	type wireStruct struct {
+		Id string `json:"id"`
+	}
+	if IsModel(reflect.TypeOf(wireStruct{})) {
+		t.Error("IsModel(wireStruct) = true, want false")
+	}
+}
+
+func TestDecode_Pointer(t *testing.T) {
+	var dst decodeTestModel
+	err := For(reflect.TypeOf(dst)).Decode(&dst, map[string]interface{}{
+		"child": map[string]interface{}{"name": "nested"},
+	})
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if dst.Child == nil || dst.Child.Name.ValueString() != "nested" {
+		t.Errorf("Child = %+v, want Name=nested", dst.Child)
+	}
+}
+
+func TestDecode_PointerNil(t *testing.T) {
+	var dst decodeTestModel
+	err := For(reflect.TypeOf(dst)).Decode(&dst, map[string]interface{}{
+		"child": nil,
+	})
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if dst.Child != nil {
+		t.Errorf("Child = %+v, want nil", dst.Child)
+	}
+}
+
+func TestDecode_Slice(t *testing.T) {
+	var dst decodeTestModel
+	err := For(reflect.TypeOf(dst)).Decode(&dst, map[string]interface{}{
+		"children": []interface{}{
+			map[string]interface{}{"name": "a"},
+			map[string]interface{}{"name": "b"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if len(dst.Children) != 2 || dst.Children[0].Name.ValueString() != "a" || dst.Children[1].Name.ValueString() != "b" {
+		t.Errorf("Children = %+v, want [a b]", dst.Children)
+	}
+}
+
+func TestDecode_CompositeFrameworkTypeWithoutHookErrors(t *testing.T) {
+	var dst decodeTestModel
+	err := For(reflect.TypeOf(dst)).Decode(&dst, map[string]interface{}{
+		"tags": []interface{}{"a", "b"},
+	})
+	if err == nil {
+		t.Fatal("Decode returned nil error for a types.List field with no registered DecodeHook, want an error")
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"terraform-provider-select/internal/codec"
+	"terraform-provider-select/internal/provider/datasource_usage_group"
+	"terraform-provider-select/internal/provider/datasource_usage_group_set"
+	"terraform-provider-select/internal/provider/datasource_usage_groups"
+	"terraform-provider-select/internal/provider/resource_usage_group"
+	"terraform-provider-select/internal/provider/resource_usage_group_set"
+	"terraform-provider-select/internal/provider/resource_usage_group_set_version"
+)
+
+// TestCodecCompilesAllModels forces every tfsdk model the provider
+// encodes/decodes through codec.For. compile() only runs lazily on a
+// model's first real Encode/Decode, so a model only exercised by a narrow
+// code path (a field only populated by certain API responses, a resource
+// untouched in a given apply) could otherwise ship with a json/tfsdk tag
+// mismatch that first panics during a customer's terraform apply instead of
+// here in CI.
+func TestCodecCompilesAllModels(t *testing.T) {
+	models := []interface{}{
+		resource_usage_group.UsageGroupModel{},
+		resource_usage_group_set.UsageGroupSetModel{},
+		resource_usage_group_set_version.UsageGroupSetVersionModel{},
+		datasource_usage_group.UsageGroupModel{},
+		datasource_usage_group_set.UsageGroupSetModel{},
+		datasource_usage_groups.UsageGroupItemModel{},
+		datasource_usage_groups.UsageGroupsModel{},
+	}
+
+	for _, model := range models {
+		model := model
+		t.Run(reflect.TypeOf(model).String(), func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Fatalf("codec.For(%T) panicked: %v", model, r)
+				}
+			}()
+			codec.For(reflect.TypeOf(model))
+		})
+	}
+}
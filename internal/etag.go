@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "sync"
+
+// ETagCache remembers the most recent ETag response header seen for each
+// endpoint, so mutating requests can send it back as If-Match and get
+// optimistic-concurrency protection from the API. This closes a race
+// window the VersionManager's per-process sharing doesn't: two engineers
+// (or two CI runs) applying against the same usage-group set concurrently
+// from different processes.
+type ETagCache struct {
+	mu     sync.Mutex
+	byPath map[string]string
+}
+
+func NewETagCache() *ETagCache {
+	return &ETagCache{byPath: make(map[string]string)}
+}
+
+func (c *ETagCache) Get(endpoint string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.byPath[endpoint]
+}
+
+func (c *ETagCache) Set(endpoint, etag string) {
+	if etag == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.byPath[endpoint] = etag
+}
+
+func (c *ETagCache) Invalidate(endpoint string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.byPath, endpoint)
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestETagCache_GetSet(t *testing.T) {
+	c := NewETagCache()
+
+	if got := c.Get("/items/1"); got != "" {
+		t.Errorf("Get on empty cache = %q, want empty", got)
+	}
+
+	c.Set("/items/1", `"etag-1"`)
+	if got := c.Get("/items/1"); got != `"etag-1"` {
+		t.Errorf("Get() = %q, want %q", got, `"etag-1"`)
+	}
+
+	// A later Set for a different path must not disturb the first.
+	c.Set("/items/2", `"etag-2"`)
+	if got := c.Get("/items/1"); got != `"etag-1"` {
+		t.Errorf("Get(/items/1) = %q after unrelated Set, want %q", got, `"etag-1"`)
+	}
+}
+
+func TestETagCache_SetEmptyIgnored(t *testing.T) {
+	c := NewETagCache()
+	c.Set("/items/1", `"etag-1"`)
+	c.Set("/items/1", "")
+	if got := c.Get("/items/1"); got != `"etag-1"` {
+		t.Errorf("Set(\"\") overwrote an existing etag: Get() = %q, want %q", got, `"etag-1"`)
+	}
+}
+
+func TestETagCache_Invalidate(t *testing.T) {
+	c := NewETagCache()
+	c.Set("/items/1", `"etag-1"`)
+	c.Invalidate("/items/1")
+	if got := c.Get("/items/1"); got != "" {
+		t.Errorf("Get() after Invalidate = %q, want empty", got)
+	}
+
+	// Invalidating a path that was never set is a no-op, not an error.
+	c.Invalidate("/items/never-set")
+}
+
+type etagTestItem struct {
+	Id types.String `tfsdk:"id"`
+}
+
+// TestAPIClient_ETagRoundTrip_412 exercises the full Get-then-Put flow: a
+// Get populates the cache from the response's ETag header, a subsequent Put
+// sends it back as If-Match, and a 412 response both surfaces a diagnostic
+// and invalidates the cached ETag so a later retry doesn't keep sending the
+// now-stale value.
+func TestAPIClient_ETagRoundTrip_412(t *testing.T) {
+	var gotIfMatch string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("ETag", `"etag-1"`)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"item-1"}`))
+		case http.MethodPut:
+			gotIfMatch = r.Header.Get("If-Match")
+			w.WriteHeader(http.StatusPreconditionFailed)
+		}
+	}))
+	defer server.Close()
+
+	client := NewAPIClient("key", "org", server.URL)
+
+	var item etagTestItem
+	if diags := client.Get(context.Background(), "/items/1", &item); diags.HasError() {
+		t.Fatalf("Get returned diagnostics: %v", diags)
+	}
+	if got := client.etags.Get("/items/1"); got != `"etag-1"` {
+		t.Fatalf("etags.Get after Get() = %q, want %q", got, `"etag-1"`)
+	}
+
+	diags := client.Put(context.Background(), "/items/1", &item, &item)
+	if !diags.HasError() {
+		t.Fatal("Put against a 412 response returned no error")
+	}
+	if gotIfMatch != `"etag-1"` {
+		t.Errorf("Put sent If-Match %q, want %q", gotIfMatch, `"etag-1"`)
+	}
+	if got := client.etags.Get("/items/1"); got != "" {
+		t.Errorf("etags.Get after a 412 = %q, want empty (cache should be invalidated)", got)
+	}
+}
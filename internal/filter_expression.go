@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// logicalOperators are the boolean combinators a filter_expression_json
+// node may use to combine child expressions.
+var logicalOperators = map[string]bool{
+	"AND": true,
+	"OR":  true,
+	"NOT": true,
+}
+
+// leafOperators are the comparison operators a filter_expression_json leaf
+// may apply to a single field.
+var leafOperators = map[string]bool{
+	"eq":       true,
+	"neq":      true,
+	"gt":       true,
+	"gte":      true,
+	"lt":       true,
+	"lte":      true,
+	"in":       true,
+	"contains": true,
+}
+
+// validateFilterExpression parses and validates a filter_expression_json
+// value against the supported operator grammar:
+//
+//	{"op": "AND"|"OR", "conditions": [<node>, ...]}
+//	{"op": "NOT", "condition": <node>}
+//	{"field": "...", "op": "eq"|"neq"|"gt"|"gte"|"lt"|"lte"|"in"|"contains", "value": <any>}
+//
+// On success it returns the canonical (sorted-key, minified) encoding of
+// the expression so that semantically-identical plans don't produce diffs.
+func validateFilterExpression(raw string) (string, error) {
+	var node interface{}
+	if err := json.Unmarshal([]byte(raw), &node); err != nil {
+		return "", fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	if err := validateFilterNode(node); err != nil {
+		return "", err
+	}
+
+	canonical, err := json.Marshal(node)
+	if err != nil {
+		return "", fmt.Errorf("failed to canonicalize: %w", err)
+	}
+
+	return string(canonical), nil
+}
+
+func validateFilterNode(node interface{}) error {
+	obj, ok := node.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("expected a JSON object, got %T", node)
+	}
+
+	opRaw, hasOp := obj["op"]
+	if !hasOp {
+		return fmt.Errorf("node is missing required \"op\" field")
+	}
+	op, ok := opRaw.(string)
+	if !ok {
+		return fmt.Errorf("\"op\" must be a string, got %T", opRaw)
+	}
+
+	switch {
+	case op == "AND" || op == "OR":
+		conditions, ok := obj["conditions"].([]interface{})
+		if !ok {
+			return fmt.Errorf("%q requires a \"conditions\" array", op)
+		}
+		if len(conditions) == 0 {
+			return fmt.Errorf("%q requires at least one condition", op)
+		}
+		for _, child := range conditions {
+			if err := validateFilterNode(child); err != nil {
+				return fmt.Errorf("invalid condition under %q: %w", op, err)
+			}
+		}
+		return nil
+
+	case op == "NOT":
+		condition, ok := obj["condition"]
+		if !ok {
+			return fmt.Errorf("\"NOT\" requires a \"condition\" field")
+		}
+		if err := validateFilterNode(condition); err != nil {
+			return fmt.Errorf("invalid condition under \"NOT\": %w", err)
+		}
+		return nil
+
+	case leafOperators[op]:
+		field, ok := obj["field"].(string)
+		if !ok || field == "" {
+			return fmt.Errorf("leaf operator %q requires a non-empty \"field\" string", op)
+		}
+		if _, hasValue := obj["value"]; !hasValue {
+			return fmt.Errorf("leaf operator %q requires a \"value\"", op)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown operator %q; supported operators are %s", op, supportedOperatorsList())
+	}
+}
+
+func supportedOperatorsList() string {
+	ops := make([]string, 0, len(logicalOperators)+len(leafOperators))
+	for op := range logicalOperators {
+		ops = append(ops, op)
+	}
+	for op := range leafOperators {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	return fmt.Sprintf("%v", ops)
+}
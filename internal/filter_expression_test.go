@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestValidateFilterExpression_Leaves(t *testing.T) {
+	for op := range leafOperators {
+		t.Run(op, func(t *testing.T) {
+			raw := `{"field":"status","op":"` + op + `","value":"active"}`
+			canonical, err := validateFilterExpression(raw)
+			if err != nil {
+				t.Fatalf("validateFilterExpression(%q) returned error: %v", raw, err)
+			}
+			if canonical == "" {
+				t.Fatalf("validateFilterExpression(%q) returned empty canonical form", raw)
+			}
+		})
+	}
+}
+
+func TestValidateFilterExpression_Logical(t *testing.T) {
+	tests := map[string]string{
+		"AND": `{"op":"AND","conditions":[{"field":"a","op":"eq","value":1},{"field":"b","op":"eq","value":2}]}`,
+		"OR":  `{"op":"OR","conditions":[{"field":"a","op":"eq","value":1},{"field":"b","op":"eq","value":2}]}`,
+		"NOT": `{"op":"NOT","condition":{"field":"a","op":"eq","value":1}}`,
+	}
+
+	for op, raw := range tests {
+		t.Run(op, func(t *testing.T) {
+			if _, err := validateFilterExpression(raw); err != nil {
+				t.Fatalf("validateFilterExpression(%q) returned error: %v", raw, err)
+			}
+		})
+	}
+}
+
+func TestValidateFilterExpression_Canonicalizes(t *testing.T) {
+	raw := "{\n  \"op\": \"eq\",\n  \"value\": 1,\n  \"field\": \"a\"\n}"
+	canonical, err := validateFilterExpression(raw)
+	if err != nil {
+		t.Fatalf("validateFilterExpression returned error: %v", err)
+	}
+
+	want := `{"field":"a","op":"eq","value":1}`
+	if canonical != want {
+		t.Errorf("canonical form = %q, want %q", canonical, want)
+	}
+
+	// Re-validating the canonical form must be stable.
+	again, err := validateFilterExpression(canonical)
+	if err != nil {
+		t.Fatalf("validateFilterExpression(canonical) returned error: %v", err)
+	}
+	if again != canonical {
+		t.Errorf("canonicalizing twice changed the result: %q then %q", canonical, again)
+	}
+}
+
+func TestValidateFilterExpression_Errors(t *testing.T) {
+	tests := map[string]string{
+		"invalid JSON":             `{"op": "eq"`,
+		"not an object":            `"just a string"`,
+		"missing op":               `{"field":"a","value":1}`,
+		"op not a string":          `{"op":1}`,
+		"unknown op":               `{"field":"a","op":"nope","value":1}`,
+		"AND missing conditions":   `{"op":"AND"}`,
+		"AND conditions not array": `{"op":"AND","conditions":"nope"}`,
+		"AND empty conditions":     `{"op":"AND","conditions":[]}`,
+		"AND invalid child":        `{"op":"AND","conditions":[{"op":"nope"}]}`,
+		"OR missing conditions":    `{"op":"OR"}`,
+		"NOT missing condition":    `{"op":"NOT"}`,
+		"NOT invalid condition":    `{"op":"NOT","condition":{"op":"nope"}}`,
+		"leaf missing field":       `{"op":"eq","value":1}`,
+		"leaf field not string":    `{"op":"eq","field":1,"value":1}`,
+		"leaf empty field":         `{"op":"eq","field":"","value":1}`,
+		"leaf missing value":       `{"op":"eq","field":"a"}`,
+	}
+
+	for name, raw := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := validateFilterExpression(raw); err == nil {
+				t.Fatalf("validateFilterExpression(%q) returned nil error, want an error", raw)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: MPL-2.0
+
+// Package httpclient_test provides a record/replay http.RoundTripper for the
+// provider's acceptance tests, so they can exercise the real request/response
+// handling in internal/api.go without a live Select account in CI. The first
+// run against a live account records every request/response pair into a
+// cassette file under testdata/; subsequent runs replay from the cassette
+// with no network traffic at all.
+//
+// It is wired into NewHTTPClient via the SELECT_VCR_CASSETTE and
+// SELECT_VCR_MODE environment variables rather than a constructor argument,
+// so acceptance tests opt in without changing how the provider is normally
+// configured.
+package httpclient_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Mode selects whether a Transport records new interactions or replays
+// previously recorded ones.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette and fails any request
+	// that doesn't match a recorded interaction. This is the mode CI runs
+	// in, since it never makes a network call.
+	ModeReplay Mode = iota
+	// ModeRecord forwards requests to the real API and appends the
+	// request/response pair to the cassette, overwriting it on each run.
+	ModeRecord
+)
+
+// ModeEnvVar, when set to "record", switches a Transport created via
+// WrapFromEnv into ModeRecord. Any other value (including unset) replays.
+const ModeEnvVar = "SELECT_VCR_MODE"
+
+// CassetteEnvVar, when set, is the path WrapFromEnv loads/writes its
+// cassette from. Acceptance tests should leave it unset outside of CI to
+// exercise the live API directly.
+const CassetteEnvVar = "SELECT_VCR_CASSETTE"
+
+// sensitiveHeaders are scrubbed from every recorded interaction so a
+// cassette can be committed to the repository without leaking credentials.
+var sensitiveHeaders = []string{"Authorization", "X-Organization-Id"}
+
+// redactedPlaceholder replaces every occurrence of a redact value (e.g. the
+// real organization id) in a recorded Path/RequestBody/ResponseBody, since
+// those are the fields that actually carry it today: org id is interpolated
+// into URL paths like /api/%s/usage-group-sets, not sent as a header.
+const redactedPlaceholder = "REDACTED"
+
+// interaction is one recorded request/response pair.
+type interaction struct {
+	Method       string              `json:"method"`
+	Path         string              `json:"path"`
+	RequestBody  string              `json:"request_body,omitempty"`
+	StatusCode   int                 `json:"status_code"`
+	ResponseBody string              `json:"response_body"`
+	Headers      map[string][]string `json:"headers,omitempty"`
+}
+
+// cassette is the on-disk JSON shape of a cassette file.
+type cassette struct {
+	Interactions []interaction `json:"interactions"`
+}
+
+// Transport wraps an http.RoundTripper with cassette-backed record/replay.
+// It is safe for concurrent use.
+type Transport struct {
+	next     http.RoundTripper
+	mode     Mode
+	path     string
+	redact   []string
+	mu       sync.Mutex
+	cassette cassette
+	// used tracks how many times each replayed interaction has already been
+	// served, so a cassette with repeated identical requests (e.g. two GETs
+	// of the same endpoint) replays them in order rather than only ever
+	// returning the first match.
+	used map[int]bool
+}
+
+// WrapFromEnv returns a Transport wrapping next if CassetteEnvVar is set,
+// or next unchanged otherwise. This is the entry point NewHTTPClient calls;
+// most callers won't need to construct a Transport directly. redact is a
+// list of literal substrings (e.g. the configured organization id) scrubbed
+// from every recorded interaction before it's written to disk.
+func WrapFromEnv(next http.RoundTripper, redact ...string) (http.RoundTripper, error) {
+	path := os.Getenv(CassetteEnvVar)
+	if path == "" {
+		return next, nil
+	}
+
+	mode := ModeReplay
+	if os.Getenv(ModeEnvVar) == "record" {
+		mode = ModeRecord
+	}
+
+	return New(next, path, mode, redact...)
+}
+
+// New returns a Transport that records into, or replays from, the cassette
+// file at path. redact values are scrubbed from Path/RequestBody/ResponseBody
+// on record, and applied to live requests before replay matching so replay
+// still finds the (now-redacted) recorded interaction.
+func New(next http.RoundTripper, path string, mode Mode, redact ...string) (*Transport, error) {
+	t := &Transport{next: next, mode: mode, path: path, redact: redact, used: map[int]bool{}}
+
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read cassette %s: %w", path, err)
+		}
+		if err := json.Unmarshal(data, &t.cassette); err != nil {
+			return nil, fmt.Errorf("parse cassette %s: %w", path, err)
+		}
+	}
+
+	return t, nil
+}
+
+// redactString replaces every occurrence of each configured redact value in
+// s with redactedPlaceholder.
+func (t *Transport) redactString(s string) string {
+	for _, value := range t.redact {
+		if value == "" {
+			continue
+		}
+		s = strings.ReplaceAll(s, value, redactedPlaceholder)
+	}
+	return s
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.mode == ModeReplay {
+		return t.replay(req)
+	}
+	return t.record(req)
+}
+
+func (t *Transport) replay(req *http.Request) (*http.Response, error) {
+	reqBody, err := readAndNormalize(req)
+	if err != nil {
+		return nil, err
+	}
+	// The cassette was recorded with redact values scrubbed out, so the live
+	// request has to go through the same scrubbing before comparison.
+	reqPath := t.redactString(req.URL.RequestURI())
+	reqBody = t.redactString(reqBody)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i, ix := range t.cassette.Interactions {
+		if t.used[i] {
+			continue
+		}
+		if ix.Method != req.Method || ix.Path != reqPath || ix.RequestBody != reqBody {
+			continue
+		}
+		t.used[i] = true
+		return &http.Response{
+			StatusCode: ix.StatusCode,
+			Status:     http.StatusText(ix.StatusCode),
+			Header:     headerFromMap(ix.Headers),
+			Body:       io.NopCloser(bytes.NewReader([]byte(ix.ResponseBody))),
+			Request:    req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("httpclient_test: no cassette interaction recorded for %s %s with body %q", req.Method, reqPath, reqBody)
+}
+
+func (t *Transport) record(req *http.Request) (*http.Response, error) {
+	reqBody, err := readAndNormalize(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	t.mu.Lock()
+	t.cassette.Interactions = append(t.cassette.Interactions, interaction{
+		Method:       req.Method,
+		Path:         t.redactString(req.URL.RequestURI()),
+		RequestBody:  t.redactString(reqBody),
+		StatusCode:   resp.StatusCode,
+		ResponseBody: t.redactString(string(respBody)),
+		Headers:      scrubHeaders(resp.Header),
+	})
+	err = t.writeLocked()
+	t.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (t *Transport) writeLocked() error {
+	data, err := json.MarshalIndent(t.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o755); err != nil {
+		return fmt.Errorf("create cassette dir: %w", err)
+	}
+	if err := os.WriteFile(t.path, data, 0o644); err != nil {
+		return fmt.Errorf("write cassette %s: %w", t.path, err)
+	}
+	return nil
+}
+
+// readAndNormalize reads req's body (restoring it for the real round trip)
+// and normalizes it with the same key-ordering-insensitive normalization
+// APIClient applies to filter_expression_json, so recorded and replayed
+// requests match regardless of Go map iteration order.
+func readAndNormalize(req *http.Request) (string, error) {
+	if req.Body == nil {
+		return "", nil
+	}
+
+	raw, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return "", fmt.Errorf("read request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(raw))
+
+	if len(raw) == 0 {
+		return "", nil
+	}
+
+	normalized, err := normalizeJSON(string(raw))
+	if err != nil {
+		// Not every request body is JSON; fall back to the raw bytes so
+		// matching still works for those.
+		return string(raw), nil
+	}
+	return normalized, nil
+}
+
+// normalizeJSON mirrors the provider's internal normalizeJSON: unmarshal and
+// re-marshal to get a stable key order. It's duplicated rather than
+// imported so this package has no dependency on the provider package it's
+// testing against.
+func normalizeJSON(jsonStr string) (string, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &v); err != nil {
+		return jsonStr, err
+	}
+	normalized, err := json.Marshal(v)
+	if err != nil {
+		return jsonStr, err
+	}
+	return string(normalized), nil
+}
+
+func scrubHeaders(h http.Header) map[string][]string {
+	out := make(map[string][]string, len(h))
+	for k, values := range h {
+		if isSensitiveHeader(k) {
+			continue
+		}
+		out[k] = values
+	}
+	return out
+}
+
+func isSensitiveHeader(name string) bool {
+	for _, h := range sensitiveHeaders {
+		if http.CanonicalHeaderKey(h) == http.CanonicalHeaderKey(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func headerFromMap(m map[string][]string) http.Header {
+	h := make(http.Header, len(m))
+	for k, values := range m {
+		h[http.CanonicalHeaderKey(k)] = values
+	}
+	return h
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package httpclient_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRedactString(t *testing.T) {
+	tr := &Transport{redact: []string{"org-123"}}
+
+	got := tr.redactString("/api/org-123/usage-group-sets/org-123-abc")
+	want := "/api/REDACTED/usage-group-sets/REDACTED-abc"
+	if got != want {
+		t.Errorf("redactString() = %q, want %q", got, want)
+	}
+
+	if got := tr.redactString("no match here"); got != "no match here" {
+		t.Errorf("redactString() = %q, want unchanged input", got)
+	}
+}
+
+func TestRecord_ScrubsRedactValuesFromCassette(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"organization_id":"org-123"}`))
+	}))
+	defer upstream.Close()
+
+	cassettePath := filepath.Join(t.TempDir(), "cassette.json")
+	tr, err := New(http.DefaultTransport, cassettePath, ModeRecord, "org-123")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, upstream.URL+"/api/org-123/usage-group-sets", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	// The live caller still sees the real, unredacted response; only what's
+	// persisted to the cassette file is scrubbed.
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read response: %v", err)
+	}
+	if !strings.Contains(string(body), "org-123") {
+		t.Errorf("response body returned to caller = %s, want unredacted org id preserved", body)
+	}
+
+	data, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("read cassette: %v", err)
+	}
+	if strings.Contains(string(data), "org-123") {
+		t.Errorf("cassette file on disk contains unredacted org id:\n%s", data)
+	}
+
+	var c cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		t.Fatalf("unmarshal cassette: %v", err)
+	}
+	if len(c.Interactions) != 1 {
+		t.Fatalf("len(Interactions) = %d, want 1", len(c.Interactions))
+	}
+	if want := "/api/REDACTED/usage-group-sets"; c.Interactions[0].Path != want {
+		t.Errorf("Interactions[0].Path = %q, want %q", c.Interactions[0].Path, want)
+	}
+}
+
+func TestReplay_MatchesRedactedLiveRequest(t *testing.T) {
+	cassettePath := filepath.Join(t.TempDir(), "cassette.json")
+	c := cassette{Interactions: []interaction{
+		{
+			Method:       http.MethodGet,
+			Path:         "/api/REDACTED/usage-group-sets",
+			StatusCode:   http.StatusOK,
+			ResponseBody: `{"ok":true}`,
+		},
+	}}
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("marshal cassette: %v", err)
+	}
+	if err := os.WriteFile(cassettePath, data, 0o644); err != nil {
+		t.Fatalf("write cassette: %v", err)
+	}
+
+	tr, err := New(nil, cassettePath, ModeReplay, "org-123")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com/api/org-123/usage-group-sets", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read response: %v", err)
+	}
+	if string(body) != `{"ok":true}` {
+		t.Errorf("body = %q, want %q", body, `{"ok":true}`)
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// RunImportConfig implements the `terraform-provider-select import-config
+// <usage_group_set_id>` subcommand. It lists every usage group in the given
+// set and writes an HCL resource stub plus a matching `terraform import`
+// command for each one, so orgs that created hundreds of groups in the
+// Select UI can bootstrap Terraform state without importing them one at a
+// time.
+//
+// Credentials are read from the same environment variables Terraform itself
+// would use to configure the provider: SELECT_API_KEY, SELECT_ORGANIZATION_ID,
+// and optionally SELECT_API_URL.
+func RunImportConfig(ctx context.Context, args []string, stdout io.Writer) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: terraform-provider-select import-config <usage_group_set_id>")
+	}
+	usageGroupSetId := args[0]
+
+	apiKey := os.Getenv("SELECT_API_KEY")
+	organizationId := os.Getenv("SELECT_ORGANIZATION_ID")
+	if apiKey == "" || organizationId == "" {
+		return fmt.Errorf("SELECT_API_KEY and SELECT_ORGANIZATION_ID must be set in the environment")
+	}
+
+	apiURL := os.Getenv("SELECT_API_URL")
+	if apiURL == "" {
+		apiURL = "https://api.select.dev"
+	}
+
+	client := NewAPIClient(apiKey, organizationId, apiURL)
+
+	groups, diags := client.ListUsageGroups(ctx, usageGroupSetId)
+	if diags.HasError() {
+		return fmt.Errorf("failed to list usage groups for set %s: %v", usageGroupSetId, diags)
+	}
+
+	// Orgs with hundreds of groups created in the Select UI routinely have
+	// two groups sharing a name (or a name that collapses to the same label
+	// after sanitization), which would otherwise emit two resource blocks
+	// with an identical label and fail terraform validate. Track labels
+	// already emitted and disambiguate with the group's id on collision.
+	seen := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		resourceName := terraformIdentifier(group.Name.ValueString(), group.Id.ValueString())
+		if seen[resourceName] {
+			resourceName = fmt.Sprintf("%s-%s", resourceName, group.Id.ValueString())
+		}
+		seen[resourceName] = true
+
+		fmt.Fprintf(stdout, "resource \"select_usage_group\" %q {\n", resourceName)
+		fmt.Fprintf(stdout, "  usage_group_set_id     = %q\n", usageGroupSetId)
+		fmt.Fprintf(stdout, "  name                   = %q\n", group.Name.ValueString())
+		fmt.Fprintf(stdout, "  order                  = %d\n", group.Order.ValueInt64())
+		fmt.Fprintf(stdout, "  budget                 = %s\n", formatBudget(group.Budget.ValueFloat64()))
+		fmt.Fprintf(stdout, "  filter_expression_json = %q\n", group.FilterExpressionJson.ValueString())
+		fmt.Fprintf(stdout, "}\n\n")
+		fmt.Fprintf(stdout, "# terraform import select_usage_group.%s %s/%s\n\n", resourceName, usageGroupSetId, group.Id.ValueString())
+	}
+
+	return nil
+}
+
+// formatBudget renders a usage group's budget as an HCL number literal.
+func formatBudget(budget float64) string {
+	return strconv.FormatFloat(budget, 'f', -1, 64)
+}
+
+// terraformIdentifier turns a usage group's display name into a valid HCL
+// resource label, falling back to its ID when the name is empty or
+// collapses entirely under sanitization.
+func terraformIdentifier(name, id string) string {
+	sanitized := make([]rune, 0, len(name))
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			sanitized = append(sanitized, r)
+		case r == ' ' || r == '-':
+			sanitized = append(sanitized, '_')
+		}
+	}
+	if len(sanitized) == 0 || (sanitized[0] >= '0' && sanitized[0] <= '9') {
+		return fmt.Sprintf("usage_group_%s", id)
+	}
+	return string(sanitized)
+}
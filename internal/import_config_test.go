@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestRunImportConfig exercises the full import-config path (env var
+// config, the mocked APIClient's HTTP round trip, HCL generation) against a
+// fake Select API server, rather than unit-testing terraformIdentifier or
+// formatBudget in isolation.
+func TestRunImportConfig(t *testing.T) {
+	const wantPath = "/api/test-org/usage-group-sets/set-123/usage-groups"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath {
+			t.Errorf("request path = %q, want %q", r.URL.Path, wantPath)
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{
+				"id": "group-1",
+				"name": "Marketing",
+				"order": 1,
+				"budget": 1500.5,
+				"filter_expression_json": "{\"field\":\"team\",\"op\":\"eq\",\"value\":\"marketing\"}",
+				"usage_group_set_id": "set-123",
+				"usage_group_set_name": "Production",
+				"created_at": "2026-01-01T00:00:00Z",
+				"updated_at": "2026-01-01T00:00:00Z"
+			},
+			{
+				"id": "group-2",
+				"name": "R&D Team!",
+				"order": 2,
+				"budget": 0,
+				"filter_expression_json": "{\"field\":\"team\",\"op\":\"eq\",\"value\":\"rd\"}",
+				"usage_group_set_id": "set-123",
+				"usage_group_set_name": "Production",
+				"created_at": "2026-01-01T00:00:00Z",
+				"updated_at": "2026-01-01T00:00:00Z"
+			}
+		]`))
+	}))
+	defer server.Close()
+
+	t.Setenv("SELECT_API_KEY", "test-key")
+	t.Setenv("SELECT_ORGANIZATION_ID", "test-org")
+	t.Setenv("SELECT_API_URL", server.URL)
+
+	var stdout bytes.Buffer
+	if err := RunImportConfig(context.Background(), []string{"set-123"}, &stdout); err != nil {
+		t.Fatalf("RunImportConfig returned error: %v", err)
+	}
+
+	out := stdout.String()
+
+	for _, want := range []string{
+		`resource "select_usage_group" "Marketing" {`,
+		`usage_group_set_id     = "set-123"`,
+		`name                   = "Marketing"`,
+		`order                  = 1`,
+		`budget                 = 1500.5`,
+		`filter_expression_json = "{\"field\":\"team\",\"op\":\"eq\",\"value\":\"marketing\"}"`,
+		`terraform import select_usage_group.Marketing set-123/group-1`,
+		`resource "select_usage_group" "RD_Team" {`,
+		`budget                 = 0`,
+		`terraform import select_usage_group.RD_Team set-123/group-2`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q\nfull output:\n%s", want, out)
+		}
+	}
+}
+
+// TestRunImportConfig_DuplicateNames exercises the disambiguation path: two
+// usage groups with the same display name must not emit two resource blocks
+// with an identical label, since that's invalid HCL and fails terraform
+// validate.
+func TestRunImportConfig_DuplicateNames(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{
+				"id": "group-1",
+				"name": "Marketing",
+				"order": 1,
+				"budget": 100,
+				"filter_expression_json": "{}",
+				"usage_group_set_id": "set-123",
+				"usage_group_set_name": "Production",
+				"created_at": "2026-01-01T00:00:00Z",
+				"updated_at": "2026-01-01T00:00:00Z"
+			},
+			{
+				"id": "group-2",
+				"name": "Marketing",
+				"order": 2,
+				"budget": 200,
+				"filter_expression_json": "{}",
+				"usage_group_set_id": "set-123",
+				"usage_group_set_name": "Production",
+				"created_at": "2026-01-01T00:00:00Z",
+				"updated_at": "2026-01-01T00:00:00Z"
+			}
+		]`))
+	}))
+	defer server.Close()
+
+	t.Setenv("SELECT_API_KEY", "test-key")
+	t.Setenv("SELECT_ORGANIZATION_ID", "test-org")
+	t.Setenv("SELECT_API_URL", server.URL)
+
+	var stdout bytes.Buffer
+	if err := RunImportConfig(context.Background(), []string{"set-123"}, &stdout); err != nil {
+		t.Fatalf("RunImportConfig returned error: %v", err)
+	}
+
+	out := stdout.String()
+
+	for _, want := range []string{
+		`resource "select_usage_group" "Marketing" {`,
+		`terraform import select_usage_group.Marketing set-123/group-1`,
+		`resource "select_usage_group" "Marketing-group-2" {`,
+		`terraform import select_usage_group.Marketing-group-2 set-123/group-2`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q\nfull output:\n%s", want, out)
+		}
+	}
+
+	if strings.Count(out, `resource "select_usage_group" "Marketing" {`) != 1 {
+		t.Errorf("expected exactly one unsuffixed \"Marketing\" resource block, full output:\n%s", out)
+	}
+}
+
+func TestRunImportConfig_MissingCredentials(t *testing.T) {
+	t.Setenv("SELECT_API_KEY", "")
+	t.Setenv("SELECT_ORGANIZATION_ID", "")
+
+	var stdout bytes.Buffer
+	if err := RunImportConfig(context.Background(), []string{"set-123"}, &stdout); err == nil {
+		t.Fatal("RunImportConfig returned nil error with no credentials set, want an error")
+	}
+}
+
+func TestRunImportConfig_WrongArgCount(t *testing.T) {
+	var stdout bytes.Buffer
+	if err := RunImportConfig(context.Background(), nil, &stdout); err == nil {
+		t.Fatal("RunImportConfig returned nil error with no usage_group_set_id argument, want an error")
+	}
+}
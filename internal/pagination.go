@@ -0,0 +1,297 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// PaginationStyle selects how GetAll/Iterate discover the next page of a
+// list endpoint.
+type PaginationStyle int
+
+const (
+	// PaginationCursor reads the next page's cursor from a field in the
+	// JSON response body (e.g. "next_cursor", "next_page_token") and sends
+	// it back as a query parameter.
+	PaginationCursor PaginationStyle = iota
+	// PaginationLinkHeader follows the RFC 5988 `Link: <url>; rel="next"`
+	// response header.
+	PaginationLinkHeader
+	// PaginationPageNumber increments a page-number query parameter until
+	// a page comes back with no items.
+	PaginationPageNumber
+)
+
+// PaginationOptions configures GetAll/Iterate for a single list endpoint.
+type PaginationOptions struct {
+	Style PaginationStyle
+
+	// ItemsField is the JSON body field holding the array of items. Leave
+	// empty if the response body itself is a JSON array.
+	ItemsField string
+
+	// CursorField is the JSON body field holding the next page's cursor
+	// (PaginationCursor only). Defaults to "next_cursor" if empty.
+	CursorField string
+	// CursorParam is the query parameter the cursor is sent back as.
+	// Defaults to "cursor" if empty.
+	CursorParam string
+
+	// PageParam is the query parameter incremented for PaginationPageNumber.
+	// Defaults to "page" if empty.
+	PageParam string
+	// PageSizeParam, if set, is sent alongside PageParam on every request.
+	PageSizeParam string
+	// PageSize is the value sent for PageSizeParam.
+	PageSize int
+}
+
+func (o PaginationOptions) cursorField() string {
+	if o.CursorField != "" {
+		return o.CursorField
+	}
+	return "next_cursor"
+}
+
+func (o PaginationOptions) cursorParam() string {
+	if o.CursorParam != "" {
+		return o.CursorParam
+	}
+	return "cursor"
+}
+
+func (o PaginationOptions) pageParam() string {
+	if o.PageParam != "" {
+		return o.PageParam
+	}
+	return "page"
+}
+
+var linkHeaderRe = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="?next"?`)
+
+// nextLinkFromHeader parses a Link header per RFC 5988 and returns the URL
+// of the rel="next" entry, or "" if there isn't one.
+func nextLinkFromHeader(header string) string {
+	match := linkHeaderRe.FindStringSubmatch(header)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// page holds one fetched page's decoded items plus whatever pagination
+// state is needed to fetch the next one.
+type page struct {
+	items    reflect.Value // slice of elemType
+	nextURL  string        // PaginationLinkHeader
+	cursor   string        // PaginationCursor
+	pageSize int           // number of items returned, used by PaginationPageNumber to detect the last page
+}
+
+func (c *APIClient) fetchPage(ctx context.Context, requestURL string, opts PaginationOptions, elemType reflect.Type) (page, diag.Diagnostics) {
+	resp, bodyStr, attempts, err := c.httpClient.executeWithRetry(ctx, http.MethodGet, requestURL, nil, "", "")
+	if err != nil {
+		return page{}, diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"HTTP Request Error",
+				fmt.Sprintf("Failed to GET %s after %s: %v", requestURL, attemptSummary(attempts), err),
+			),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return page{}, handleResponseError(fmt.Sprintf("GET %s", requestURL), resp.StatusCode, bodyStr)
+	}
+
+	var itemsRaw json.RawMessage
+	var cursor, nextURL string
+
+	if opts.ItemsField == "" {
+		itemsRaw = json.RawMessage(bodyStr)
+		if opts.Style == PaginationLinkHeader {
+			nextURL = nextLinkFromHeader(resp.Header.Get("Link"))
+		}
+	} else {
+		var envelope map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(bodyStr), &envelope); err != nil {
+			return page{}, handleJSONError("unmarshal paginated response", err)
+		}
+		itemsRaw = envelope[opts.ItemsField]
+		if opts.Style == PaginationCursor {
+			if raw, ok := envelope[opts.cursorField()]; ok {
+				_ = json.Unmarshal(raw, &cursor)
+			}
+		} else if opts.Style == PaginationLinkHeader {
+			nextURL = nextLinkFromHeader(resp.Header.Get("Link"))
+		}
+	}
+
+	var rawItems []json.RawMessage
+	if err := json.Unmarshal(itemsRaw, &rawItems); err != nil {
+		return page{}, handleJSONError("unmarshal page items", err)
+	}
+
+	items := reflect.MakeSlice(reflect.SliceOf(elemType), 0, len(rawItems))
+	for _, raw := range rawItems {
+		elemPtr := reflect.New(elemType)
+		var rawMap map[string]interface{}
+		if err := json.Unmarshal(raw, &rawMap); err != nil {
+			return page{}, handleJSONError("unmarshal page item", err)
+		}
+		if err := updateTerraformFromAPI(elemPtr.Interface(), rawMap); err != nil {
+			return page{}, handleJSONError("decode page item", err)
+		}
+		items = reflect.Append(items, elemPtr.Elem())
+	}
+
+	return page{items: items, nextURL: nextURL, cursor: cursor, pageSize: len(rawItems)}, nil
+}
+
+func appendQueryParam(rawURL, key, value string) string {
+	separator := "?"
+	if strings.Contains(rawURL, "?") {
+		separator = "&"
+	}
+	return fmt.Sprintf("%s%s%s=%s", rawURL, separator, key, value)
+}
+
+// GetAll walks every page of a paginated list endpoint and appends the
+// decoded items into responseSlice, which must be a pointer to a slice of
+// a tfsdk-style struct (the same shape APIClient.Get decodes into).
+func (c *APIClient) GetAll(ctx context.Context, endpoint string, opts PaginationOptions, responseSlice interface{}) diag.Diagnostics {
+	sliceValue := reflect.ValueOf(responseSlice)
+	if sliceValue.Kind() != reflect.Ptr || sliceValue.Elem().Kind() != reflect.Slice {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("Invalid GetAll Target", "responseSlice must be a pointer to a slice"),
+		}
+	}
+	elemType := sliceValue.Elem().Type().Elem()
+	result := reflect.MakeSlice(sliceValue.Elem().Type(), 0, 0)
+
+	cursor := ""
+	pageNum := 1
+	requestURL := endpoint
+
+	for {
+		url := requestURL
+		switch opts.Style {
+		case PaginationCursor:
+			if cursor != "" {
+				url = appendQueryParam(endpoint, opts.cursorParam(), cursor)
+			}
+		case PaginationPageNumber:
+			url = appendQueryParam(endpoint, opts.pageParam(), strconv.Itoa(pageNum))
+			if opts.PageSizeParam != "" && opts.PageSize > 0 {
+				url = appendQueryParam(url, opts.PageSizeParam, strconv.Itoa(opts.PageSize))
+			}
+		}
+
+		p, diags := c.fetchPage(ctx, url, opts, elemType)
+		if diags.HasError() {
+			return diags
+		}
+
+		result = reflect.AppendSlice(result, p.items)
+
+		switch opts.Style {
+		case PaginationCursor:
+			if p.cursor == "" {
+				sliceValue.Elem().Set(result)
+				return nil
+			}
+			cursor = p.cursor
+		case PaginationLinkHeader:
+			if p.nextURL == "" {
+				sliceValue.Elem().Set(result)
+				return nil
+			}
+			requestURL = p.nextURL
+		case PaginationPageNumber:
+			if p.pageSize == 0 {
+				sliceValue.Elem().Set(result)
+				return nil
+			}
+			pageNum++
+		}
+	}
+}
+
+// Iterate streams a paginated list endpoint one decoded item at a time over
+// the returned channel, for result sets too large to hold comfortably in
+// memory as a single slice. Both channels close once iteration finishes or
+// an error occurs; callers should drain both with a select loop.
+func (c *APIClient) Iterate(ctx context.Context, endpoint string, opts PaginationOptions, elemType reflect.Type) (<-chan json.RawMessage, <-chan error) {
+	items := make(chan json.RawMessage)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(items)
+		defer close(errs)
+
+		cursor := ""
+		pageNum := 1
+		requestURL := endpoint
+
+		for {
+			url := requestURL
+			switch opts.Style {
+			case PaginationCursor:
+				if cursor != "" {
+					url = appendQueryParam(endpoint, opts.cursorParam(), cursor)
+				}
+			case PaginationPageNumber:
+				url = appendQueryParam(endpoint, opts.pageParam(), strconv.Itoa(pageNum))
+			}
+
+			p, diags := c.fetchPage(ctx, url, opts, elemType)
+			if diags.HasError() {
+				errs <- fmt.Errorf("%v", diags)
+				return
+			}
+
+			for i := 0; i < p.items.Len(); i++ {
+				raw, err := json.Marshal(p.items.Index(i).Interface())
+				if err != nil {
+					errs <- err
+					return
+				}
+				select {
+				case items <- raw:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			switch opts.Style {
+			case PaginationCursor:
+				if p.cursor == "" {
+					return
+				}
+				cursor = p.cursor
+			case PaginationLinkHeader:
+				if p.nextURL == "" {
+					return
+				}
+				requestURL = p.nextURL
+			case PaginationPageNumber:
+				if p.pageSize == 0 {
+					return
+				}
+				pageNum++
+			}
+		}
+	}()
+
+	return items, errs
+}
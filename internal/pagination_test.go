@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type paginationTestItem struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// TestGetAll_PaginationCursor exercises the cursor style end to end: the
+// fake server hands back a next_cursor field until the final page, which
+// omits it.
+func TestGetAll_PaginationCursor(t *testing.T) {
+	pages := [][]string{
+		{"a", "b"},
+		{"c"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pageIdx := 0
+		if r.URL.Query().Get("cursor") == "page-1" {
+			pageIdx = 1
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"items":[`)
+		for i, name := range pages[pageIdx] {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"id":%q,"name":%q}`, name, name)
+		}
+		fmt.Fprint(w, "]")
+		if pageIdx == 0 {
+			fmt.Fprint(w, `,"next_cursor":"page-1"`)
+		}
+		fmt.Fprint(w, "}")
+	}))
+	defer server.Close()
+
+	client := NewAPIClient("key", "org", server.URL)
+	var items []paginationTestItem
+	diags := client.GetAll(context.Background(), "/items", PaginationOptions{ItemsField: "items"}, &items)
+	if diags.HasError() {
+		t.Fatalf("GetAll returned diagnostics: %v", diags)
+	}
+
+	var got []string
+	for _, item := range items {
+		got = append(got, item.Id.ValueString())
+	}
+	want := []string{"a", "b", "c"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("items = %v, want %v", got, want)
+	}
+}
+
+// TestGetAll_PaginationLinkHeader exercises the Link: rel="next" style
+// against a bare JSON array body (no ItemsField), which is what
+// ListUsageGroups relies on since b30f64a.
+func TestGetAll_PaginationLinkHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/items" {
+			// Link values are relative endpoints, like every other URL this
+			// client builds: fetchPage hands nextURL straight back to
+			// executeWithRetry, which re-prepends the client's baseURL.
+			w.Header().Set("Link", `</items/page2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":"a","name":"a"}]`)
+			return
+		}
+		// Second page: no Link header, so GetAll must stop here.
+		fmt.Fprint(w, `[{"id":"b","name":"b"}]`)
+	}))
+	defer server.Close()
+
+	client := NewAPIClient("key", "org", server.URL)
+	var items []paginationTestItem
+	diags := client.GetAll(context.Background(), "/items", PaginationOptions{Style: PaginationLinkHeader}, &items)
+	if diags.HasError() {
+		t.Fatalf("GetAll returned diagnostics: %v", diags)
+	}
+
+	var got []string
+	for _, item := range items {
+		got = append(got, item.Id.ValueString())
+	}
+	want := []string{"a", "b"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("items = %v, want %v", got, want)
+	}
+}
+
+// TestGetAll_PaginationLinkHeader_SinglePage guards against the truncation
+// bug fixed in b30f64a: a response with no Link header at all must still
+// return its one page of items instead of erroring or looping.
+func TestGetAll_PaginationLinkHeader_SinglePage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":"only","name":"only"}]`)
+	}))
+	defer server.Close()
+
+	client := NewAPIClient("key", "org", server.URL)
+	var items []paginationTestItem
+	diags := client.GetAll(context.Background(), "/items", PaginationOptions{Style: PaginationLinkHeader}, &items)
+	if diags.HasError() {
+		t.Fatalf("GetAll returned diagnostics: %v", diags)
+	}
+	if len(items) != 1 || items[0].Id.ValueString() != "only" {
+		t.Errorf("items = %+v, want a single item %q", items, "only")
+	}
+}
+
+// TestGetAll_PaginationPageNumber exercises the page-number style: the
+// server returns pages until one comes back empty.
+func TestGetAll_PaginationPageNumber(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `[{"id":"a","name":"a"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":"b","name":"b"}]`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	}))
+	defer server.Close()
+
+	client := NewAPIClient("key", "org", server.URL)
+	var items []paginationTestItem
+	diags := client.GetAll(context.Background(), "/items", PaginationOptions{Style: PaginationPageNumber}, &items)
+	if diags.HasError() {
+		t.Fatalf("GetAll returned diagnostics: %v", diags)
+	}
+
+	var got []string
+	for _, item := range items {
+		got = append(got, item.Id.ValueString())
+	}
+	want := []string{"a", "b"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("items = %v, want %v", got, want)
+	}
+}
+
+func TestGetAll_InvalidTarget(t *testing.T) {
+	client := NewAPIClient("key", "org", "http://example.invalid")
+	var notASlice paginationTestItem
+	diags := client.GetAll(context.Background(), "/items", PaginationOptions{}, &notASlice)
+	if !diags.HasError() {
+		t.Fatal("GetAll with a non-slice target returned no error")
+	}
+}
+
+func TestNextLinkFromHeader(t *testing.T) {
+	cases := []struct {
+		header string
+		want   string
+	}{
+		{``, ""},
+		{`<https://api.select.dev/items?cursor=abc>; rel="next"`, "https://api.select.dev/items?cursor=abc"},
+		{`<https://api.select.dev/items?cursor=abc>; rel=next`, "https://api.select.dev/items?cursor=abc"},
+		{`<https://api.select.dev/items?cursor=abc>; rel="prev"`, ""},
+	}
+	for _, c := range cases {
+		if got := nextLinkFromHeader(c.header); got != c.want {
+			t.Errorf("nextLinkFromHeader(%q) = %q, want %q", c.header, got, c.want)
+		}
+	}
+}
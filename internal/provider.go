@@ -4,8 +4,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,9 +18,14 @@ import (
 var _ provider.Provider = (*selectProvider)(nil)
 
 type ProviderModel struct {
-	ApiKey         types.String `tfsdk:"api_key"`
-	OrganizationId types.String `tfsdk:"organization_id"`
-	ApiURL         types.String `tfsdk:"select_api_url"`
+	ApiKey               types.String `tfsdk:"api_key"`
+	OrganizationId       types.String `tfsdk:"organization_id"`
+	ApiURL               types.String `tfsdk:"select_api_url"`
+	RetryMaxAttempts     types.Int64  `tfsdk:"retry_max_attempts"`
+	RetryMaxElapsed      types.String `tfsdk:"retry_max_elapsed"`
+	RetryInitialInterval types.String `tfsdk:"retry_initial_interval"`
+	RetryMaxInterval     types.String `tfsdk:"retry_max_interval"`
+	RetryPost            types.Bool   `tfsdk:"retry_post"`
 }
 
 type ProviderData struct {
@@ -48,6 +56,26 @@ func (p *selectProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:    true,
 				Description: "Base URL for the Select API",
 			},
+			"retry_max_attempts": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of attempts (including the initial try) for a single API request before giving up. Defaults to 5.",
+			},
+			"retry_max_elapsed": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum total time to spend retrying a single API request, expressed as a Go duration string (e.g. \"2m\"). Defaults to \"2m\".",
+			},
+			"retry_initial_interval": schema.StringAttribute{
+				Optional:    true,
+				Description: "Initial backoff delay before the first retry, expressed as a Go duration string (e.g. \"500ms\"). Doubles with jitter on each subsequent retry. Defaults to \"500ms\".",
+			},
+			"retry_max_interval": schema.StringAttribute{
+				Optional:    true,
+				Description: "Upper bound on the backoff delay between retries, expressed as a Go duration string (e.g. \"30s\"). A server-supplied Retry-After still overrides this. Defaults to \"30s\".",
+			},
+			"retry_post": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether POST requests (usage group/set creates) are retried on transient failures. POSTs are always sent with an Idempotency-Key, so retrying them is safe; set this to false to opt out. Defaults to true.",
+			},
 		},
 	}
 }
@@ -99,7 +127,51 @@ func (p *selectProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		apiURL = "https://api.select.dev"
 	}
 
-	client := NewAPIClient(apiKey, organizationId, apiURL)
+	retryConfig := DefaultRetryConfig()
+	if !config.RetryMaxAttempts.IsNull() && !config.RetryMaxAttempts.IsUnknown() {
+		retryConfig.MaxAttempts = int(config.RetryMaxAttempts.ValueInt64())
+	}
+	if v := config.RetryMaxElapsed.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_elapsed"),
+				"Invalid Duration",
+				fmt.Sprintf("retry_max_elapsed must be a valid Go duration string (e.g. \"2m\"): %v", err),
+			)
+			return
+		}
+		retryConfig.MaxElapsedTime = d
+	}
+	if v := config.RetryInitialInterval.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_initial_interval"),
+				"Invalid Duration",
+				fmt.Sprintf("retry_initial_interval must be a valid Go duration string (e.g. \"500ms\"): %v", err),
+			)
+			return
+		}
+		retryConfig.InitialInterval = d
+	}
+	if v := config.RetryMaxInterval.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_interval"),
+				"Invalid Duration",
+				fmt.Sprintf("retry_max_interval must be a valid Go duration string (e.g. \"30s\"): %v", err),
+			)
+			return
+		}
+		retryConfig.MaxInterval = d
+	}
+	if !config.RetryPost.IsNull() && !config.RetryPost.IsUnknown() {
+		retryConfig.RetryPost = config.RetryPost.ValueBool()
+	}
+
+	client := NewAPIClientWithRetryConfig(apiKey, organizationId, apiURL, retryConfig)
 
 	providerData := &ProviderData{
 		Client: client,
@@ -114,12 +186,17 @@ func (p *selectProvider) Metadata(ctx context.Context, req provider.MetadataRequ
 }
 
 func (p *selectProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewUsageGroupSetDataSource,
+		NewUsageGroupDataSource,
+		NewUsageGroupsDataSource,
+	}
 }
 
 func (p *selectProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewUsageGroupSetResource,
 		NewUsageGroupResource,
+		NewUsageGroupSetVersionResource,
 	}
 }
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MPL-2.0
+
+// Package datasource_usage_group holds the select_usage_group data source's
+// schema and model; see internal/provider/README.md for the subpackage
+// layout this follows.
+package datasource_usage_group
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UsageGroupModel mirrors resource_usage_group.UsageGroupModel for the
+// single-item lookup data source.
+type UsageGroupModel struct {
+	Id                   types.String  `tfsdk:"id"`
+	Name                 types.String  `tfsdk:"name"`
+	Order                types.Int64   `tfsdk:"order"`
+	Budget               types.Float64 `tfsdk:"budget"`
+	FilterExpressionJson types.String  `tfsdk:"filter_expression_json"`
+	OrganizationId       types.String  `tfsdk:"organization_id"`
+	UsageGroupSetId      types.String  `tfsdk:"usage_group_set_id"`
+	UsageGroupSetName    types.String  `tfsdk:"usage_group_set_name"`
+	CreatedAt            types.String  `tfsdk:"created_at"`
+	UpdatedAt            types.String  `tfsdk:"updated_at"`
+}
+
+func UsageGroupDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Looks up a single existing usage group within a usage group set by id or name.",
+		Attributes: map[string]schema.Attribute{
+			"usage_group_set_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier of the usage group set the usage group belongs to.",
+			},
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier of the usage group. Either id or name must be provided.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the usage group. Either id or name must be provided.",
+			},
+			"order": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Evaluation order of the usage group within its set.",
+			},
+			"budget": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Budget assigned to the usage group.",
+			},
+			"filter_expression_json": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON-encoded filter expression that determines which usage is attributed to this group.",
+			},
+			"organization_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Organization ID that owns the usage group.",
+			},
+			"usage_group_set_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the usage group set the usage group belongs to.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp the usage group was created.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp the usage group was last updated.",
+			},
+		},
+	}
+}
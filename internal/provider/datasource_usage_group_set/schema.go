@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: MPL-2.0
+
+// Package datasource_usage_group_set holds the select_usage_group_set data
+// source's schema and model; see internal/provider/README.md for the
+// subpackage layout this follows.
+package datasource_usage_group_set
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UsageGroupSetModel mirrors resource_usage_group_set.UsageGroupSetModel but
+// is kept as a distinct type since data sources never need the Order field
+// to be settable the way the resource's plan does, and so the two schemas
+// can evolve independently.
+type UsageGroupSetModel struct {
+	Id                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	Order                     types.Int64  `tfsdk:"order"`
+	OrganizationId            types.String `tfsdk:"organization_id"`
+	SnowflakeAccountUuid      types.String `tfsdk:"snowflake_account_uuid"`
+	SnowflakeOrganizationName types.String `tfsdk:"snowflake_organization_name"`
+	TeamId                    types.String `tfsdk:"team_id"`
+}
+
+func UsageGroupSetDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Looks up an existing usage group set by id or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier of the usage group set. Either id or name must be provided.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the usage group set. Either id or name must be provided.",
+			},
+			"order": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Evaluation order of the usage group set.",
+			},
+			"organization_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Organization ID that owns the usage group set.",
+			},
+			"snowflake_account_uuid": schema.StringAttribute{
+				Computed:    true,
+				Description: "Snowflake account UUID this usage group set is scoped to, if any.",
+			},
+			"snowflake_organization_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Snowflake organization name this usage group set is scoped to, if any.",
+			},
+			"team_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Team ID this usage group set is scoped to, if any.",
+			},
+		},
+	}
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: MPL-2.0
+
+// Package datasource_usage_groups holds the select_usage_groups (list) data
+// source's schema and model; see internal/provider/README.md for the
+// subpackage layout this follows.
+package datasource_usage_groups
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UsageGroupItemModel is a single entry in UsageGroupsModel.UsageGroups,
+// mirroring resource_usage_group.UsageGroupModel's API-visible fields.
+type UsageGroupItemModel struct {
+	Id                   types.String  `tfsdk:"id"`
+	Name                 types.String  `tfsdk:"name"`
+	Order                types.Int64   `tfsdk:"order"`
+	Budget               types.Float64 `tfsdk:"budget"`
+	FilterExpressionJson types.String  `tfsdk:"filter_expression_json"`
+	CreatedAt            types.String  `tfsdk:"created_at"`
+	UpdatedAt            types.String  `tfsdk:"updated_at"`
+}
+
+type UsageGroupsModel struct {
+	UsageGroupSetId types.String          `tfsdk:"usage_group_set_id"`
+	OrganizationId  types.String          `tfsdk:"organization_id"`
+	UsageGroups     []UsageGroupItemModel `tfsdk:"usage_groups"`
+}
+
+func UsageGroupsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists all usage groups within a usage group set.",
+		Attributes: map[string]schema.Attribute{
+			"usage_group_set_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier of the usage group set to list usage groups for.",
+			},
+			"organization_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Organization ID that owns the usage group set.",
+			},
+			"usage_groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All usage groups belonging to the usage group set.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the usage group.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the usage group.",
+						},
+						"order": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Evaluation order of the usage group within its set.",
+						},
+						"budget": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Budget assigned to the usage group.",
+						},
+						"filter_expression_json": schema.StringAttribute{
+							Computed:    true,
+							Description: "JSON-encoded filter expression that determines which usage is attributed to this group.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp the usage group was created.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp the usage group was last updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MPL-2.0
+
+// Package resource_usage_group_set_version holds the
+// select_usage_group_set_version resource's schema and model; see
+// internal/provider/README.md for the subpackage layout this follows.
+package resource_usage_group_set_version
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UsageGroupSetVersionModel backs the select_usage_group_set_version
+// resource. Id holds the version ID returned by the Select API; the
+// importable identifier is "usage_group_set_id/version_id".
+type UsageGroupSetVersionModel struct {
+	Id                types.String `tfsdk:"id"`
+	UsageGroupSetId   types.String `tfsdk:"usage_group_set_id"`
+	RollbackOnFailure types.Bool   `tfsdk:"rollback_on_failure"`
+	PublishedAt       types.String `tfsdk:"published_at"`
+}
+
+func UsageGroupSetVersionResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Opens a draft version of a usage_group_set, shared with any select_usage_group resources mutated in the same apply, and publishes it. Use depends_on to ensure child usage groups are created/updated before this resource publishes them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the published version.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"usage_group_set_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier of the usage group set this version belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rollback_on_failure": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "When true, if publishing this version fails the draft is discarded instead of being left open. Defaults to false. This only covers a failure of this resource's own Publish call; the framework gives resources no way to observe diagnostics reported by sibling select_usage_group resources elsewhere in the same apply, so a child resource failing after the draft was opened does not trigger a rollback here.",
+			},
+			"published_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp the version was published.",
+			},
+		},
+	}
+}
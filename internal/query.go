@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Query is a set of query-string parameters, supporting repeated keys
+// (e.g. ?tag=a&tag=b). Callers can build one directly or pass a struct
+// tagged `url:"name,omitempty"` to Get/Delete and have it encoded
+// automatically via buildQuery.
+type Query map[string][]string
+
+// nameFilter is passed to GetQuery by the usage_group and usage_group_set
+// data sources' by-name lookups, so the API filters server-side instead of
+// every list endpoint being fetched in full and filtered in Go.
+type nameFilter struct {
+	Name string `url:"name,omitempty"`
+}
+
+// Add appends value under key, preserving any values already set.
+func (q Query) Add(key, value string) Query {
+	q[key] = append(q[key], value)
+	return q
+}
+
+// Encode renders the query string in a stable (key-sorted) order so
+// requests are deterministic, which matters for acceptance-test cassette
+// replay matching.
+func (q Query) Encode() string {
+	values := url.Values{}
+	for key, vals := range q {
+		for _, v := range vals {
+			values.Add(key, v)
+		}
+	}
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for i, k := range keys {
+		for j, v := range q[k] {
+			if i > 0 || j > 0 {
+				b.WriteByte('&')
+			}
+			b.WriteString(url.QueryEscape(k))
+			b.WriteByte('=')
+			b.WriteString(url.QueryEscape(v))
+		}
+	}
+	return b.String()
+}
+
+// buildQuery turns query into a Query, accepting nil, a Query value
+// directly, or a struct whose fields are tagged `url:"name"` (optionally
+// `,omitempty`). Slice and array fields become repeated parameters.
+// Terraform framework types (types.String, types.Int64, etc.) and structs
+// nested via a `url` tag are both supported, so schema-backed filter blocks
+// can be passed straight through without manual conversion.
+func buildQuery(query interface{}) (Query, error) {
+	if query == nil {
+		return nil, nil
+	}
+	if q, ok := query.(Query); ok {
+		return q, nil
+	}
+
+	q := Query{}
+	if err := encodeQueryStruct(q, reflect.ValueOf(query)); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+func encodeQueryStruct(q Query, v reflect.Value) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("query value must be a struct or Query, got %s", v.Kind())
+	}
+
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		field := t.Field(i)
+		value := v.Field(i)
+		if !value.CanInterface() {
+			continue
+		}
+
+		tag := field.Tag.Get("url")
+		if tag == "-" {
+			continue
+		}
+		name, omitempty := parseURLTag(tag, field.Name)
+
+		if err := encodeQueryField(q, name, value, omitempty); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseURLTag(tag, fieldName string) (name string, omitempty bool) {
+	if tag == "" {
+		return fieldName, false
+	}
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = fieldName
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitempty = true
+		}
+	}
+	return name, omitempty
+}
+
+func encodeQueryField(q Query, name string, value reflect.Value, omitempty bool) error {
+	// Framework types know their own null/unknown state; treat both as
+	// "not set" regardless of omitempty.
+	switch v := value.Interface().(type) {
+	case types.String:
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+		q.Add(name, v.ValueString())
+		return nil
+	case types.Int64:
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+		q.Add(name, strconv.FormatInt(v.ValueInt64(), 10))
+		return nil
+	case types.Bool:
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+		q.Add(name, strconv.FormatBool(v.ValueBool()))
+		return nil
+	case types.Float64:
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+		q.Add(name, strconv.FormatFloat(v.ValueFloat64(), 'f', -1, 64))
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil
+		}
+		return encodeQueryField(q, name, value.Elem(), omitempty)
+
+	case reflect.Slice, reflect.Array:
+		if omitempty && value.Len() == 0 {
+			return nil
+		}
+		for i := 0; i < value.Len(); i++ {
+			if err := encodeQueryField(q, name, value.Index(i), false); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.String:
+		s := value.String()
+		if omitempty && s == "" {
+			return nil
+		}
+		q.Add(name, s)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := value.Int()
+		if omitempty && n == 0 {
+			return nil
+		}
+		q.Add(name, strconv.FormatInt(n, 10))
+		return nil
+
+	case reflect.Bool:
+		b := value.Bool()
+		if omitempty && !b {
+			return nil
+		}
+		q.Add(name, strconv.FormatBool(b))
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		f := value.Float()
+		if omitempty && f == 0 {
+			return nil
+		}
+		q.Add(name, strconv.FormatFloat(f, 'f', -1, 64))
+		return nil
+
+	case reflect.Struct:
+		return encodeQueryStruct(q, value)
+
+	default:
+		return fmt.Errorf("unsupported query field kind %s for %q", value.Kind(), name)
+	}
+}
+
+// GetQuery is like Get but appends query, which may be a Query built with
+// Add or a struct tagged `url:"..."`, to the endpoint before issuing the
+// request.
+func (c *APIClient) GetQuery(ctx context.Context, endpoint string, query interface{}, responseBody interface{}) diag.Diagnostics {
+	q, err := buildQuery(query)
+	if err != nil {
+		return handleJSONError("build query", err)
+	}
+	return c.Get(ctx, withQuery(endpoint, q), responseBody)
+}
+
+// DeleteQuery is like Delete but appends query to the endpoint before
+// issuing the request.
+func (c *APIClient) DeleteQuery(ctx context.Context, endpoint string, query interface{}) diag.Diagnostics {
+	q, err := buildQuery(query)
+	if err != nil {
+		return handleJSONError("build query", err)
+	}
+	return c.Delete(ctx, withQuery(endpoint, q))
+}
+
+func withQuery(endpoint string, q Query) string {
+	if len(q) == 0 {
+		return endpoint
+	}
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+	return endpoint + separator + q.Encode()
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildQuery_Nil(t *testing.T) {
+	q, err := buildQuery(nil)
+	if err != nil {
+		t.Fatalf("buildQuery(nil) returned error: %v", err)
+	}
+	if q != nil {
+		t.Errorf("buildQuery(nil) = %v, want nil", q)
+	}
+}
+
+func TestBuildQuery_QueryPassthrough(t *testing.T) {
+	in := Query{}.Add("tag", "a").Add("tag", "b")
+	q, err := buildQuery(in)
+	if err != nil {
+		t.Fatalf("buildQuery returned error: %v", err)
+	}
+	if q.Encode() != "tag=a&tag=b" {
+		t.Errorf("Encode() = %q, want %q", q.Encode(), "tag=a&tag=b")
+	}
+}
+
+func TestBuildQuery_FrameworkTypes(t *testing.T) {
+	type filter struct {
+		Name    types.String  `url:"name,omitempty"`
+		Order   types.Int64   `url:"order,omitempty"`
+		Enabled types.Bool    `url:"enabled,omitempty"`
+		Budget  types.Float64 `url:"budget,omitempty"`
+	}
+
+	in := filter{
+		Name:    types.StringValue("marketing"),
+		Order:   types.Int64Null(),
+		Enabled: types.BoolValue(true),
+		Budget:  types.Float64Unknown(),
+	}
+
+	q, err := buildQuery(in)
+	if err != nil {
+		t.Fatalf("buildQuery returned error: %v", err)
+	}
+	want := "enabled=true&name=marketing"
+	if got := q.Encode(); got != want {
+		t.Errorf("Encode() = %q, want %q (null/unknown fields should be omitted regardless of omitempty)", got, want)
+	}
+}
+
+func TestBuildQuery_OmitemptyAndRepeated(t *testing.T) {
+	type filter struct {
+		Name string   `url:"name,omitempty"`
+		Tags []string `url:"tag,omitempty"`
+		Page int      `url:"page,omitempty"`
+	}
+
+	in := filter{Name: "", Tags: []string{"a", "b"}, Page: 0}
+	q, err := buildQuery(in)
+	if err != nil {
+		t.Fatalf("buildQuery returned error: %v", err)
+	}
+	want := "tag=a&tag=b"
+	if got := q.Encode(); got != want {
+		t.Errorf("Encode() = %q, want %q (empty name and zero page should be omitted, tags repeated)", got, want)
+	}
+}
+
+func TestBuildQuery_NestedStructAndPointer(t *testing.T) {
+	type inner struct {
+		City string `url:"city,omitempty"`
+	}
+	type filter struct {
+		Inner inner  `url:"inner"`
+		Ptr   *inner `url:"ptr"`
+	}
+
+	in := filter{Inner: inner{City: "nyc"}, Ptr: &inner{City: "sf"}}
+	q, err := buildQuery(in)
+	if err != nil {
+		t.Fatalf("buildQuery returned error: %v", err)
+	}
+	if got := q.Encode(); got != "city=nyc&city=sf" {
+		t.Errorf("Encode() = %q, want %q", got, "city=nyc&city=sf")
+	}
+}
+
+func TestBuildQuery_NilPointerSkipped(t *testing.T) {
+	type inner struct {
+		City string `url:"city,omitempty"`
+	}
+	type filter struct {
+		Ptr *inner `url:"ptr"`
+	}
+
+	q, err := buildQuery(filter{})
+	if err != nil {
+		t.Fatalf("buildQuery returned error: %v", err)
+	}
+	if got := q.Encode(); got != "" {
+		t.Errorf("Encode() = %q, want empty (nil pointer field should be skipped)", got)
+	}
+}
+
+func TestBuildQuery_NotAStruct(t *testing.T) {
+	if _, err := buildQuery("not a struct"); err == nil {
+		t.Fatal("buildQuery(\"not a struct\") returned nil error, want an error")
+	}
+}
+
+func TestWithQuery(t *testing.T) {
+	if got := withQuery("/items", Query{}); got != "/items" {
+		t.Errorf("withQuery with empty Query = %q, want unchanged endpoint", got)
+	}
+	if got := withQuery("/items", Query{}.Add("a", "1")); got != "/items?a=1" {
+		t.Errorf("withQuery = %q, want %q", got, "/items?a=1")
+	}
+	if got := withQuery("/items?existing=1", Query{}.Add("a", "1")); got != "/items?existing=1&a=1" {
+		t.Errorf("withQuery = %q, want %q", got, "/items?existing=1&a=1")
+	}
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RetryConfig controls how APIClient retries transient HTTP failures.
+// It is populated from the provider schema (retry_max_attempts,
+// retry_max_elapsed, retry_initial_interval) and defaults to values that
+// are safe for the Select API's default rate limits.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of times a request will be tried,
+	// including the initial attempt. A value of 1 disables retries.
+	MaxAttempts int
+	// MaxElapsedTime bounds the total wall-clock time spent retrying a
+	// single logical request, regardless of MaxAttempts.
+	MaxElapsedTime time.Duration
+	// InitialInterval is the base delay before the first retry; each
+	// subsequent retry doubles the previous delay (capped) and adds jitter.
+	InitialInterval time.Duration
+	// MaxInterval caps the backoff delay between retries.
+	MaxInterval time.Duration
+	// RetryPost controls whether POST requests are retried at all. When
+	// true (the default), a POST is still only retried if the caller
+	// attached an Idempotency-Key, since that's what makes a retried
+	// create safe; setting this to false disables POST retries entirely.
+	RetryPost bool
+}
+
+// DefaultRetryConfig mirrors the defaults used by most Terraform providers
+// that wrap rate-limited SaaS APIs (e.g. Couchbase Capella's provider).
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts:     5,
+		MaxElapsedTime:  2 * time.Minute,
+		InitialInterval: 500 * time.Millisecond,
+		MaxInterval:     30 * time.Second,
+		RetryPost:       true,
+	}
+}
+
+// parseRetryAfter parses a Retry-After header value in either the
+// delta-seconds form ("120") or the HTTP-date form
+// ("Fri, 31 Dec 1999 23:59:59 GMT") and returns the resulting delay. It
+// returns 0 if the header is empty, unparseable, or already in the past.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if when, err := http.ParseTime(value); err == nil {
+		d := time.Until(when)
+		if d < 0 {
+			return 0
+		}
+		return d
+	}
+
+	return 0
+}
+
+// retryAttempt records what happened on a single try so the caller can
+// surface a meaningful diagnostic if every attempt ultimately fails.
+type retryAttempt struct {
+	attempt    int
+	statusCode int
+	err        error
+}
+
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	// Connection resets, refused connections, timeouts, etc. are all
+	// reported as net.Error (or wrap one) and are safe to retry since no
+	// response was ever read from the server.
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+// backoffDelay returns the delay before the given retry attempt (1-indexed),
+// applying full jitter: a random duration between 0 and the exponential cap.
+func backoffDelay(cfg RetryConfig, attempt int) time.Duration {
+	max := cfg.InitialInterval << uint(attempt-1)
+	if max <= 0 || max > cfg.MaxInterval {
+		max = cfg.MaxInterval
+	}
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+// sleepCtx sleeps for d, returning early with ctx.Err() if ctx is cancelled
+// before the delay elapses.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// newIdempotencyKey generates a fresh key for a single logical POST call so
+// that retries of that call (including ones the caller issues after a
+// network error with an indeterminate outcome) are deduplicated server-side
+// instead of creating duplicate usage groups.
+func newIdempotencyKey() string {
+	return uuid.NewString()
+}
+
+func attemptSummary(attempts []retryAttempt) string {
+	if len(attempts) == 0 {
+		return "no attempts made"
+	}
+	last := attempts[len(attempts)-1]
+	if last.err != nil {
+		return fmt.Sprintf("%d attempt(s), last error: %v", len(attempts), last.err)
+	}
+	return fmt.Sprintf("%d attempt(s), last status: %d", len(attempts), last.statusCode)
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter_DeltaSeconds(t *testing.T) {
+	if got := parseRetryAfter("120"); got != 120*time.Second {
+		t.Errorf("parseRetryAfter(\"120\") = %v, want %v", got, 120*time.Second)
+	}
+}
+
+func TestParseRetryAfter_NegativeDeltaSeconds(t *testing.T) {
+	if got := parseRetryAfter("-5"); got != 0 {
+		t.Errorf("parseRetryAfter(\"-5\") = %v, want 0", got)
+	}
+}
+
+func TestParseRetryAfter_Zero(t *testing.T) {
+	if got := parseRetryAfter("0"); got != 0 {
+		t.Errorf("parseRetryAfter(\"0\") = %v, want 0", got)
+	}
+}
+
+func TestParseRetryAfter_HTTPDate(t *testing.T) {
+	future := time.Now().Add(90 * time.Second).UTC()
+	got := parseRetryAfter(future.Format(http.TimeFormat))
+	if got <= 0 || got > 91*time.Second {
+		t.Errorf("parseRetryAfter(future HTTP-date) = %v, want a positive duration close to 90s", got)
+	}
+}
+
+func TestParseRetryAfter_HTTPDateInThePast(t *testing.T) {
+	past := time.Now().Add(-90 * time.Second).UTC()
+	if got := parseRetryAfter(past.Format(http.TimeFormat)); got != 0 {
+		t.Errorf("parseRetryAfter(past HTTP-date) = %v, want 0", got)
+	}
+}
+
+func TestParseRetryAfter_Empty(t *testing.T) {
+	if got := parseRetryAfter(""); got != 0 {
+		t.Errorf("parseRetryAfter(\"\") = %v, want 0", got)
+	}
+}
+
+func TestParseRetryAfter_Unparseable(t *testing.T) {
+	if got := parseRetryAfter("not-a-valid-value"); got != 0 {
+		t.Errorf("parseRetryAfter(garbage) = %v, want 0", got)
+	}
+}
+
+func TestBackoffDelay_WithinCap(t *testing.T) {
+	cfg := RetryConfig{InitialInterval: 500 * time.Millisecond, MaxInterval: 30 * time.Second}
+
+	for attempt := 1; attempt <= 10; attempt++ {
+		d := backoffDelay(cfg, attempt)
+		if d < 0 || d > cfg.MaxInterval {
+			t.Errorf("backoffDelay(attempt=%d) = %v, want within [0, %v]", attempt, d, cfg.MaxInterval)
+		}
+	}
+}
+
+func TestBackoffDelay_ZeroInitialInterval(t *testing.T) {
+	// A zero (or otherwise non-positive) exponential cap falls back to
+	// MaxInterval rather than collapsing the delay to 0.
+	cfg := RetryConfig{InitialInterval: 0, MaxInterval: 30 * time.Second}
+	got := backoffDelay(cfg, 1)
+	if got < 0 || got > cfg.MaxInterval {
+		t.Errorf("backoffDelay with zero InitialInterval = %v, want within [0, %v]", got, cfg.MaxInterval)
+	}
+}
+
+func TestBackoffDelay_ZeroMaxInterval(t *testing.T) {
+	cfg := RetryConfig{InitialInterval: 500 * time.Millisecond, MaxInterval: 0}
+	if got := backoffDelay(cfg, 1); got != 0 {
+		t.Errorf("backoffDelay with zero MaxInterval = %v, want 0", got)
+	}
+}
+
+func TestBackoffDelay_OverflowFallsBackToMaxInterval(t *testing.T) {
+	cfg := RetryConfig{InitialInterval: 500 * time.Millisecond, MaxInterval: 30 * time.Second}
+	// A high attempt number left-shifts InitialInterval far past MaxInterval
+	// (and eventually past int64 range); backoffDelay must clamp rather than
+	// panic or return a negative/zero delay from the overflow.
+	d := backoffDelay(cfg, 100)
+	if d < 0 || d > cfg.MaxInterval {
+		t.Errorf("backoffDelay(attempt=100) = %v, want within [0, %v]", d, cfg.MaxInterval)
+	}
+}
+
+func TestIsRetryableStatus(t *testing.T) {
+	for _, code := range []int{429, 502, 503, 504} {
+		if !isRetryableStatus(code) {
+			t.Errorf("isRetryableStatus(%d) = false, want true", code)
+		}
+	}
+	for _, code := range []int{200, 400, 401, 404, 500} {
+		if isRetryableStatus(code) {
+			t.Errorf("isRetryableStatus(%d) = true, want false", code)
+		}
+	}
+}
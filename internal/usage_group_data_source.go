@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-select/internal/provider/datasource_usage_group"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*usageGroupDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*usageGroupDataSource)(nil)
+
+func NewUsageGroupDataSource() datasource.DataSource {
+	return &usageGroupDataSource{}
+}
+
+type usageGroupDataSource struct {
+	client *APIClient
+}
+
+func (d *usageGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *usageGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage_group"
+}
+
+func (d *usageGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_usage_group.UsageGroupDataSourceSchema(ctx)
+}
+
+func (d *usageGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_usage_group.UsageGroupModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId := d.client.GetOrganizationId()
+	usageGroupSetId := data.UsageGroupSetId.ValueString()
+	id := data.Id.ValueString()
+	name := data.Name.ValueString()
+
+	if usageGroupSetId == "" {
+		resp.Diagnostics.AddError(
+			"Missing Usage Group Set ID",
+			"usage_group_set_id is required to look up a usage_group.",
+		)
+		return
+	}
+
+	if id == "" && name == "" {
+		resp.Diagnostics.AddError(
+			"Missing Lookup Key",
+			"Either id or name must be set to look up a usage_group.",
+		)
+		return
+	}
+
+	if id != "" {
+		endpoint := fmt.Sprintf("/api/%s/usage-group-sets/%s/usage-groups/%s", orgId, usageGroupSetId, id)
+		diags := d.client.Get(ctx, endpoint, &data)
+		if isNotFoundDiagnostic(diags) {
+			resp.Diagnostics.AddError(
+				"Usage Group Not Found",
+				fmt.Sprintf("No usage_group with id %q was found in usage_group_set %s.", id, usageGroupSetId),
+			)
+			return
+		}
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.OrganizationId = types.StringValue(orgId)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// GetQuery pushes the name filter down to the API instead of listing
+	// every usage group in the set and filtering client-side; the exact
+	// match below still applies in case the API's name filter is fuzzy.
+	var groups []datasource_usage_group.UsageGroupModel
+	endpoint := fmt.Sprintf("/api/%s/usage-group-sets/%s/usage-groups", orgId, usageGroupSetId)
+	resp.Diagnostics.Append(d.client.GetQuery(ctx, endpoint, nameFilter{Name: name}, &groups)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, group := range groups {
+		if group.Name.ValueString() == name {
+			group.OrganizationId = types.StringValue(orgId)
+			group.UsageGroupSetId = types.StringValue(usageGroupSetId)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &group)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Usage Group Not Found",
+		fmt.Sprintf("No usage_group with name %q was found in usage_group_set %s.", name, usageGroupSetId),
+	)
+}
@@ -17,6 +17,7 @@ import (
 var _ resource.Resource = (*usageGroupResource)(nil)
 var _ resource.ResourceWithConfigure = (*usageGroupResource)(nil)
 var _ resource.ResourceWithImportState = (*usageGroupResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*usageGroupResource)(nil)
 
 func NewUsageGroupResource() resource.Resource {
 	return &usageGroupResource{}
@@ -53,6 +54,54 @@ func (r *usageGroupResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = baseSchema
 }
 
+// ModifyPlan validates filter_expression_json against the supported
+// operator grammar (AND/OR/NOT combinators over field/op/value leaves) and
+// canonicalizes it to sorted-key, minified JSON. Canonicalizing means a
+// plan that only differs from state by whitespace or key order resolves to
+// the same string as state, so it no longer shows up as a diff.
+func (r *usageGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Destroy plan; nothing to validate.
+		return
+	}
+
+	var plan resource_usage_group.UsageGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.FilterExpressionJson.IsNull() || plan.FilterExpressionJson.IsUnknown() {
+		return
+	}
+
+	canonical, err := validateFilterExpression(plan.FilterExpressionJson.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("filter_expression_json"),
+			"Invalid filter_expression_json",
+			err.Error(),
+		)
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state resource_usage_group.UsageGroupModel
+		if diags := req.State.Get(ctx, &state); !diags.HasError() && !state.FilterExpressionJson.IsNull() {
+			if stateCanonical, err := validateFilterExpression(state.FilterExpressionJson.ValueString()); err == nil && stateCanonical == canonical {
+				// Cosmetic-only change (whitespace, key order); keep the
+				// prior value so this attribute doesn't show up as a diff.
+				plan.FilterExpressionJson = state.FilterExpressionJson
+				resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+				return
+			}
+		}
+	}
+
+	plan.FilterExpressionJson = types.StringValue(canonical)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (r *usageGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resource_usage_group.UsageGroupModel
 
@@ -146,6 +195,22 @@ func (r *usageGroupResource) ImportState(ctx context.Context, req resource.Impor
 	usageGroupSetID := parts[0]
 	usageGroupID := parts[1]
 
+	if usageGroupID == "*" {
+		// A single ImportState call can only populate one resource's
+		// state, so bulk import of every group in the set can't happen
+		// here. Point the user at the companion generator instead, which
+		// writes an HCL resource block plus a matching `terraform import`
+		// command for each group it discovers.
+		resp.Diagnostics.AddError(
+			"Bulk Import Not Supported via `terraform import`",
+			fmt.Sprintf(
+				"Importing all usage groups in a set requires generating one resource block per group. Run `terraform-provider-select import-config %s` to produce HCL stubs and import commands for every usage group in this set.",
+				usageGroupSetID,
+			),
+		)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("usage_group_set_id"), usageGroupSetID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), usageGroupID)...)
 }
@@ -269,6 +334,33 @@ func updateUsageGroup(ctx context.Context, model *resource_usage_group.UsageGrou
 	return diags
 }
 
+// ListUsageGroups returns every usage group within usageGroupSetId. It
+// backs both the select_usage_groups data source and the
+// `terraform-provider-select import-config` bulk-import generator. It goes
+// through GetAll rather than a plain Get so a usage_group_set with more
+// results than fit in one page doesn't silently truncate. PaginationStyle
+// defaults to PaginationLinkHeader with ItemsField left empty: the endpoint
+// returns a bare JSON array today, and GetAll follows the Link: rel="next"
+// response header when present, which works against a bare array response
+// without assuming a particular pagination envelope.
+func (c *APIClient) ListUsageGroups(ctx context.Context, usageGroupSetId string) ([]resource_usage_group.UsageGroupModel, diag.Diagnostics) {
+	orgId := c.GetOrganizationId()
+	endpoint := fmt.Sprintf("/api/%s/usage-group-sets/%s/usage-groups", orgId, usageGroupSetId)
+
+	var groups []resource_usage_group.UsageGroupModel
+	diags := c.GetAll(ctx, endpoint, PaginationOptions{Style: PaginationLinkHeader}, &groups)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for i := range groups {
+		groups[i].OrganizationId = types.StringValue(orgId)
+		groups[i].UsageGroupSetId = types.StringValue(usageGroupSetId)
+	}
+
+	return groups, diags
+}
+
 func deleteUsageGroup(ctx context.Context, model *resource_usage_group.UsageGroupModel, client *APIClient) diag.Diagnostics {
 	orgId := client.GetOrganizationId()
 	usageGroupSetId := model.UsageGroupSetId.ValueString()
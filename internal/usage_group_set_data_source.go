@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-select/internal/provider/datasource_usage_group_set"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*usageGroupSetDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*usageGroupSetDataSource)(nil)
+
+func NewUsageGroupSetDataSource() datasource.DataSource {
+	return &usageGroupSetDataSource{}
+}
+
+type usageGroupSetDataSource struct {
+	client *APIClient
+}
+
+func (d *usageGroupSetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *usageGroupSetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage_group_set"
+}
+
+func (d *usageGroupSetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_usage_group_set.UsageGroupSetDataSourceSchema(ctx)
+}
+
+func (d *usageGroupSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_usage_group_set.UsageGroupSetModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId := d.client.GetOrganizationId()
+	id := data.Id.ValueString()
+	name := data.Name.ValueString()
+
+	if id == "" && name == "" {
+		resp.Diagnostics.AddError(
+			"Missing Lookup Key",
+			"Either id or name must be set to look up a usage_group_set.",
+		)
+		return
+	}
+
+	if id != "" {
+		endpoint := fmt.Sprintf("/api/%s/usage-group-sets/%s", orgId, id)
+		diags := d.client.Get(ctx, endpoint, &data)
+		if isNotFoundDiagnostic(diags) {
+			resp.Diagnostics.AddError(
+				"Usage Group Set Not Found",
+				fmt.Sprintf("No usage_group_set with id %q was found in organization %s.", id, orgId),
+			)
+			return
+		}
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.OrganizationId = types.StringValue(orgId)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// GetQuery pushes the name filter down to the API instead of listing
+	// every usage group set and filtering client-side; the exact match
+	// below still applies in case the API's name filter is fuzzy.
+	var sets []datasource_usage_group_set.UsageGroupSetModel
+	endpoint := fmt.Sprintf("/api/%s/usage-group-sets", orgId)
+	diags := d.client.GetQuery(ctx, endpoint, nameFilter{Name: name}, &sets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, set := range sets {
+		if set.Name.ValueString() == name {
+			set.OrganizationId = types.StringValue(orgId)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &set)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Usage Group Set Not Found",
+		fmt.Sprintf("No usage_group_set with name %q was found in organization %s.", name, orgId),
+	)
+}
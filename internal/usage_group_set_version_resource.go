@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-select/internal/provider/resource_usage_group_set_version"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*usageGroupSetVersionResource)(nil)
+var _ resource.ResourceWithConfigure = (*usageGroupSetVersionResource)(nil)
+var _ resource.ResourceWithImportState = (*usageGroupSetVersionResource)(nil)
+
+func NewUsageGroupSetVersionResource() resource.Resource {
+	return &usageGroupSetVersionResource{}
+}
+
+type usageGroupSetVersionResource struct {
+	client *APIClient
+}
+
+func (r *usageGroupSetVersionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *usageGroupSetVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage_group_set_version"
+}
+
+func (r *usageGroupSetVersionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_usage_group_set_version.UsageGroupSetVersionResourceSchema(ctx)
+}
+
+// Create opens (or reuses, if one was already opened by a select_usage_group
+// resource earlier in this apply) the shared draft version for
+// usage_group_set_id and publishes it. List child select_usage_group
+// resources in this resource's depends_on so they apply, and therefore
+// mutate the draft, before it is published here.
+//
+// rollback_on_failure only discards the draft when this resource's own
+// Publish call fails (below); the framework has no cross-resource
+// diagnostics channel, so there is no way for this resource to observe a
+// sibling select_usage_group resource failing earlier in the same apply and
+// roll back in response to that.
+func (r *usageGroupSetVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_usage_group_set_version.UsageGroupSetVersionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usageGroupSetId := data.UsageGroupSetId.ValueString()
+	rollbackOnFailure := data.RollbackOnFailure.ValueBool()
+
+	versionId, diags := r.client.VersionManager().GetOrCreate(ctx, usageGroupSetId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	publishDiags := r.client.VersionManager().Publish(ctx, usageGroupSetId, versionId)
+	if publishDiags.HasError() {
+		resp.Diagnostics.Append(publishDiags...)
+
+		if rollbackOnFailure {
+			discardDiags := r.client.VersionManager().Discard(ctx, usageGroupSetId, versionId)
+			resp.Diagnostics.Append(discardDiags...)
+		}
+		return
+	}
+
+	data.Id = types.StringValue(versionId)
+	data.RollbackOnFailure = types.BoolValue(rollbackOnFailure)
+	data.PublishedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *usageGroupSetVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_usage_group_set_version.UsageGroupSetVersionModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId := r.client.GetOrganizationId()
+	endpoint := fmt.Sprintf("/api/%s/usage-group-sets/%s/versions/%s", orgId, data.UsageGroupSetId.ValueString(), data.Id.ValueString())
+
+	var versionResponse VersionResponse
+	resp.Diagnostics.Append(r.client.Get(ctx, endpoint, &versionResponse)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only ever changes rollback_on_failure; the published version
+// itself is immutable (usage_group_set_id forces replacement).
+func (r *usageGroupSetVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan resource_usage_group_set_version.UsageGroupSetVersionModel
+	var state resource_usage_group_set_version.UsageGroupSetVersionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.RollbackOnFailure = plan.RollbackOnFailure
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *usageGroupSetVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removing this resource from configuration does not unpublish an
+	// already-published version; there is nothing further to do here.
+}
+
+// ImportState accepts "usage_group_set_id/version_id" and registers the
+// adopted version with the client's VersionManager so any select_usage_group
+// resources applied later in the same run reuse this draft instead of
+// opening a new one.
+func (r *usageGroupSetVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID Format",
+			fmt.Sprintf("Expected import ID in format 'usage_group_set_id/version_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	usageGroupSetId, versionId := parts[0], parts[1]
+
+	r.client.VersionManager().Adopt(usageGroupSetId, versionId)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("usage_group_set_id"), usageGroupSetId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), versionId)...)
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-select/internal/provider/datasource_usage_groups"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*usageGroupsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*usageGroupsDataSource)(nil)
+
+func NewUsageGroupsDataSource() datasource.DataSource {
+	return &usageGroupsDataSource{}
+}
+
+type usageGroupsDataSource struct {
+	client *APIClient
+}
+
+func (d *usageGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *usageGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage_groups"
+}
+
+func (d *usageGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_usage_groups.UsageGroupsDataSourceSchema(ctx)
+}
+
+func (d *usageGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_usage_groups.UsageGroupsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usageGroupSetId := data.UsageGroupSetId.ValueString()
+	if usageGroupSetId == "" {
+		resp.Diagnostics.AddError(
+			"Missing Usage Group Set ID",
+			"usage_group_set_id is required to list usage_groups.",
+		)
+		return
+	}
+
+	orgId := d.client.GetOrganizationId()
+	endpoint := fmt.Sprintf("/api/%s/usage-group-sets/%s/usage-groups", orgId, usageGroupSetId)
+
+	// GetAll rather than a plain Get so a usage_group_set with more results
+	// than fit in one page doesn't silently truncate. PaginationLinkHeader
+	// with ItemsField left empty follows Link: rel="next" against the bare
+	// JSON array this endpoint returns today.
+	var groups []datasource_usage_groups.UsageGroupItemModel
+	resp.Diagnostics.Append(d.client.GetAll(ctx, endpoint, PaginationOptions{Style: PaginationLinkHeader}, &groups)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrganizationId = types.StringValue(orgId)
+	data.UsageGroups = groups
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// VersionManager hands out a single draft version per usage_group_set for
+// the lifetime of an apply, so every select_usage_group resource mutating
+// groups within the same set — and the select_usage_group_set_version
+// resource that publishes or rolls back that draft — share one version
+// handle instead of each opening its own.
+type VersionManager struct {
+	client *APIClient
+
+	mu       sync.Mutex
+	versions map[string]*versionHandle
+}
+
+type versionHandle struct {
+	once sync.Once
+	id   string
+	err  error
+}
+
+func NewVersionManager(client *APIClient) *VersionManager {
+	return &VersionManager{
+		client:   client,
+		versions: make(map[string]*versionHandle),
+	}
+}
+
+func (m *VersionManager) handleFor(usageGroupSetId string) *versionHandle {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h, ok := m.versions[usageGroupSetId]
+	if !ok {
+		h = &versionHandle{}
+		m.versions[usageGroupSetId] = h
+	}
+	return h
+}
+
+// GetOrCreate opens a draft version for usageGroupSetId the first time it is
+// called for that set during this apply, and returns the same version ID on
+// every subsequent call for the same set.
+func (m *VersionManager) GetOrCreate(ctx context.Context, usageGroupSetId string) (string, diag.Diagnostics) {
+	h := m.handleFor(usageGroupSetId)
+
+	h.once.Do(func() {
+		orgId := m.client.GetOrganizationId()
+		endpoint := fmt.Sprintf("/api/%s/usage-group-sets/%s/versions", orgId, usageGroupSetId)
+
+		var versionResponse VersionResponse
+		diags := m.client.Post(ctx, endpoint, map[string]interface{}{}, &versionResponse)
+		if diags.HasError() {
+			h.err = fmt.Errorf("failed to create version: %v", diags)
+			return
+		}
+
+		if versionResponse.Id == "" {
+			h.err = fmt.Errorf("API returned empty version ID")
+			return
+		}
+
+		h.id = versionResponse.Id
+	})
+
+	if h.err != nil {
+		return "", diag.Diagnostics{
+			diag.NewErrorDiagnostic("Version Creation Error", h.err.Error()),
+		}
+	}
+
+	return h.id, diag.Diagnostics{}
+}
+
+// Adopt registers an already-existing draft version for usageGroupSetId,
+// e.g. one adopted via `terraform import set_id/version_id`, so later
+// GetOrCreate calls in the same apply reuse it instead of opening a new one.
+func (m *VersionManager) Adopt(usageGroupSetId, versionId string) {
+	h := m.handleFor(usageGroupSetId)
+	h.once.Do(func() {
+		h.id = versionId
+	})
+}
+
+// Publish marks the draft version for usageGroupSetId as the set's active
+// version.
+func (m *VersionManager) Publish(ctx context.Context, usageGroupSetId, versionId string) diag.Diagnostics {
+	orgId := m.client.GetOrganizationId()
+	endpoint := fmt.Sprintf("/api/%s/usage-group-sets/%s/versions/%s/publish", orgId, usageGroupSetId, versionId)
+	return m.client.Post(ctx, endpoint, map[string]interface{}{}, nil)
+}
+
+// Discard rolls back the draft version for usageGroupSetId, undoing every
+// mutation made against it during this apply.
+func (m *VersionManager) Discard(ctx context.Context, usageGroupSetId, versionId string) diag.Diagnostics {
+	orgId := m.client.GetOrganizationId()
+	endpoint := fmt.Sprintf("/api/%s/usage-group-sets/%s/versions/%s", orgId, usageGroupSetId, versionId)
+	return m.client.Delete(ctx, endpoint)
+}
@@ -5,12 +5,20 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 	provider "terraform-provider-select/internal"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "import-config" {
+		if err := provider.RunImportConfig(context.Background(), os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	opts := providerserver.ServeOpts{
 		Address: "registry.terraform.io/get-select/select",
 	}